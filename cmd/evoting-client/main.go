@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
 	"flag"
 	"fmt"
 	"io"
@@ -10,6 +12,7 @@ import (
 	"path"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 	"github.com/chzyer/readline"
 	"github.com/jamesruan/sodium"
@@ -17,6 +20,7 @@ import (
 	"github.com/xdavidwu/evoting/store"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -36,7 +40,47 @@ Flags:
 	shellUsage	= `Commands:
   create NAME:        Create an election
   vote ELECTION NAME: Vote for NAME on ELECTION
+  abstain ELECTION:   Cast a blank ballot on ELECTION
+  ballot ELECTION:    Answer each question of ELECTION
+  adjudicate ELECTION:
+                      Merge write-ins of ELECTION you created after it ends
   result ELECTION:    Query ELECTION result
+  list [open|closed] [eligible] [mine]:
+                      List elections, optionally only open or closed ones,
+                      ones you may vote in, or ones you created
+  show ELECTION:      Show details of ELECTION
+  roll ELECTION include|exclude|remove NAME|@FILE...:
+                      Update the roll of ELECTION you created, with voter
+                      names, or files with a name on each line, and
+                      refreeze its electorate
+  snapshot ELECTION:  Refreeze the electorate of ELECTION you created, to
+                      take registration changes into account
+  nominate ELECTION NAME:
+                      Nominate voter NAME as a candidate in ELECTION
+  second ELECTION NAME:
+                      Second the nomination of NAME in ELECTION
+  accept|decline ELECTION:
+                      Accept or decline your nomination in ELECTION
+  nominations ELECTION:
+                      List nominations in ELECTION
+  contribute ELECTION:
+                      Contribute random bytes to the beacon breaking ties in
+                      ELECTION, as one of its trustees
+  turnout ELECTION [INTERVAL]:
+                      Show turnout of ELECTION you created or certify, by
+                      group and by INTERVAL (e.g. 30m, default 1h)
+  watch ELECTION:     Notify of events of ELECTION, such as it opening,
+                      reaching turnout milestones (for its creator and
+                      officers), closing, and its result being certified,
+                      in the background
+  certify ELECTION:   Countersign the result document of ELECTION, as one
+                      of its certifying officers
+  delegate election|group SCOPE NAME:
+                      Let voter NAME vote for you in election SCOPE, or in
+                      elections of group SCOPE and its subgroups, unless you
+                      vote yourself
+  revoke election|group SCOPE:
+                      Revoke your delegation for SCOPE
   exit, quit, q:      Exit
 `
 	shellPrompt	= "evoting> "
@@ -77,6 +121,220 @@ func retryWithAuth[T interface{}](s clientState, f func(clientState) T, retries
 	return v
 }
 
+// listResult bundles ListElections results for retryWithAuth.
+type listResult struct {
+	list	*pb.ElectionList
+	err	error
+}
+
+func (r listResult) unpack() (*pb.ElectionList, error) {
+	return r.list, r.err
+}
+
+// turnoutResult bundles GetTurnout results for retryWithAuth.
+type turnoutResult struct {
+	stats	*pb.TurnoutStats
+	err	error
+}
+
+func (r turnoutResult) unpack() (*pb.TurnoutStats, error) {
+	return r.stats, r.err
+}
+
+func eventString(e *pb.ElectionEvent) string {
+	switch e.GetKind() {
+	case pb.EventKind_EVENT_OPENED:
+		return "voting opened"
+	case pb.EventKind_EVENT_TURNOUT:
+		return fmt.Sprintf("turnout reached %d%%", e.GetPercent())
+	case pb.EventKind_EVENT_CLOSED:
+		return "voting closed"
+	case pb.EventKind_EVENT_RESULT:
+		return "result available"
+	case pb.EventKind_EVENT_CERTIFIED:
+		return fmt.Sprintf("result certified by %s", e.GetOfficer())
+	case pb.EventKind_EVENT_CANCELLED:
+		return "cancelled as no nominee qualified"
+	default:
+		return "unknown event"
+	}
+}
+
+// watch prints events of election as they come, resuming after the last one
+// whenever the stream breaks, as on failover of the server.
+func watch(s clientState, election string, stdout io.Writer) {
+	var after int64
+	for {
+		stream, err := s.client.WatchElection(context.Background(), &pb.WatchRequest{
+			ElectionName: &election,
+			After: &after,
+			Token: s.token,
+		})
+		for err == nil {
+			var e *pb.ElectionEvent
+			e, err = stream.Recv()
+			if err == nil {
+				after = *e.Id
+				fmt.Fprintf(stdout, "%s: %s at %s\n", election, eventString(e), e.At.AsTime().Local().Format(time.DateTime))
+			}
+		}
+		switch pb.Reason(pb.ToError(err)) {
+		case pb.ReasonWatchElectionNotFound:
+			log.Printf("fail to watch %s: %v", election, pb.ToError(err))
+			return
+		case pb.ReasonWatchElectionUnauthn:
+			// expired while watching
+			obtainToken(&s)
+			continue
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+func stateString(s pb.ElectionState) string {
+	switch s {
+	case pb.ElectionState_ELECTION_OPEN:
+		return "open"
+	case pb.ElectionState_ELECTION_CLOSED:
+		return "closed"
+	case pb.ElectionState_ELECTION_NOMINATING:
+		return "nominating"
+	case pb.ElectionState_ELECTION_CANCELLED:
+		return "cancelled"
+	default:
+		return "unknown"
+	}
+}
+
+// thresholds are names of thresholds for create.
+var thresholds = map[string]pb.Threshold{
+	"plurality": pb.Threshold_THRESHOLD_PLURALITY,
+	"majority": pb.Threshold_THRESHOLD_SIMPLE_MAJORITY,
+	"two-thirds": pb.Threshold_THRESHOLD_TWO_THIRDS,
+	"absolute": pb.Threshold_THRESHOLD_ABSOLUTE_MAJORITY,
+}
+
+// ballotTypes are names of ballot types for create.
+var ballotTypes = map[string]pb.BallotType{
+	"single": pb.BallotType_BALLOT_SINGLE,
+	"approval": pb.BallotType_BALLOT_APPROVAL,
+	"cumulative": pb.BallotType_BALLOT_CUMULATIVE,
+	"borda": pb.BallotType_BALLOT_BORDA,
+	"quadratic": pb.BallotType_BALLOT_QUADRATIC,
+	"budget": pb.BallotType_BALLOT_BUDGET,
+}
+
+// fundings are names of funding methods for create.
+var fundings = map[string]pb.Funding{
+	"greedy": pb.Funding_FUNDING_GREEDY,
+	"equal-shares": pb.Funding_FUNDING_EQUAL_SHARES,
+}
+
+func ballotTypeString(t pb.BallotType) string {
+	for name, v := range ballotTypes {
+		if v == t {
+			return name
+		}
+	}
+	return "unknown"
+}
+
+func thresholdString(t pb.Threshold) string {
+	for name, v := range thresholds {
+		if v == t {
+			return name
+		}
+	}
+	return "unknown"
+}
+
+func fundingString(f pb.Funding) string {
+	for name, v := range fundings {
+		if v == f {
+			return name
+		}
+	}
+	return "unknown"
+}
+
+// expandNames replaces @FILE arguments with names in FILE, one on each line.
+func expandNames(args []string) ([]string, error) {
+	names := []string{}
+	for _, arg := range args {
+		if !strings.HasPrefix(arg, "@") {
+			names = append(names, arg)
+			continue
+		}
+		b, err := os.ReadFile(arg[1:])
+		if err != nil {
+			return nil, fmt.Errorf("cannot read names: %w", err)
+		}
+		for _, line := range strings.Split(string(b), "\n") {
+			line = strings.TrimSpace(line)
+			if line != "" {
+				names = append(names, line)
+			}
+		}
+	}
+	return names, nil
+}
+
+func rollEntries(include, exclude []string) ([]*pb.RollEntry, error) {
+	include, err := expandNames(include)
+	if err != nil {
+		return nil, err
+	}
+	exclude, err = expandNames(exclude)
+	if err != nil {
+		return nil, err
+	}
+	roll := []*pb.RollEntry{}
+	for i := range include {
+		roll = append(roll, &pb.RollEntry{Name: &include[i]})
+	}
+	yes := true
+	for i := range exclude {
+		roll = append(roll, &pb.RollEntry{Name: &exclude[i], Exclude: &yes})
+	}
+	return roll, nil
+}
+
+// groupWeights parses GROUP=WEIGHT overrides.
+func groupWeights(fields []string) ([]*pb.GroupWeight, error) {
+	weights := []*pb.GroupWeight{}
+	for _, f := range fields {
+		group, weightStr, ok := strings.Cut(f, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s is not GROUP=WEIGHT", f)
+		}
+		weight, err := strconv.ParseInt(weightStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad weight of %s: %w", group, err)
+		}
+		weights = append(weights, &pb.GroupWeight{Group: &group, Weight: &weight})
+	}
+	return weights, nil
+}
+
+func printCount(w io.Writer, r *pb.VoteCount, suffix string) {
+	fmt.Fprintf(w, "%s%s:\t%d", *r.ChoiceName, suffix, *r.Count)
+	if r.WeightedCount != nil {
+		fmt.Fprintf(w, "\t(weighted %d)", *r.WeightedCount)
+	}
+	if r.GetProxyCount() != 0 {
+		fmt.Fprintf(w, "\t(%d by proxy)", *r.ProxyCount)
+	}
+	if r.Credits != nil {
+		fmt.Fprintf(w, "\t(net %d votes, %d credits)", r.GetPoints(), *r.Credits)
+	} else if r.Points != nil {
+		fmt.Fprintf(w, "\t(%d points)", *r.Points)
+	}
+	if len(r.Merged) != 0 {
+		fmt.Fprintf(w, "\t(merged from %s)", strings.Join(r.Merged, ", "))
+	}
+	fmt.Fprintln(w)
+}
+
 func ask(l *readline.Instance, prompt string) string {
 	l.HistoryDisable()
 	l.SetPrompt(prompt)
@@ -149,6 +407,7 @@ func main() {
 	log.SetOutput(l.Stderr())
 
 	stdout := l.Stdout()
+	watching := map[string]bool{}
 
 	for {
 		line, err := l.Readline()
@@ -188,6 +447,31 @@ func main() {
 				}
 			}
 
+			var nominationsEnd *timestamppb.Timestamp
+			var seconds int32
+			var acceptance bool
+			for {
+				timeStr := ask(l, "take nominations until (format as in " + time.DateTime + ", empty for none): ")
+				if timeStr == "" {
+					break
+				}
+				nt, err := time.ParseInLocation(time.DateTime, timeStr, time.Local)
+				if err == nil {
+					nominationsEnd = timestamppb.New(nt)
+					break
+				}
+			}
+			if nominationsEnd != nil {
+				for {
+					n, err := strconv.Atoi(ask(l, " seconds needed to qualify: "))
+					if err == nil && n >= 0 {
+						seconds = int32(n)
+						break
+					}
+				}
+				acceptance = strings.ToLower(ask(l, " nominees must accept (y/N): ")) == "y"
+			}
+
 			var ng int
 			for {
 				ngStr := ask(l, "number of groups to allow: ")
@@ -202,60 +486,293 @@ func main() {
 				groups[i] = ask(l, " group name: ")
 			}
 
-			var nc int
+			var nq int
+			for {
+				nq, err = strconv.Atoi(ask(l, "number of questions: "))
+				if err == nil && nq > 0 {
+					break
+				}
+			}
+
+			questions := make([]*pb.Question, nq)
+			for i := range questions {
+				q := &pb.Question{}
+				if nq > 1 {
+					title := ask(l, fmt.Sprintf("title of question %d: ", i + 1))
+					q.Title = &title
+				}
+
+				var nc int
+				prompt := " number of choices: "
+				if i == 0 && nominationsEnd != nil {
+					prompt = " number of choices besides nominees: "
+				}
+				for {
+					ncStr := ask(l, prompt)
+					nc, err = strconv.Atoi(ncStr)
+					if err == nil {
+						break
+					}
+				}
+
+				q.Choices = make([]string, nc)
+				for j := 0; j < nc; j++ {
+					q.Choices[j] = ask(l, "  choice: ")
+				}
+
+				for {
+					name := ask(l, " ballot type (single, approval, cumulative, borda, quadratic, budget; default single): ")
+					if name == "" {
+						name = "single"
+					}
+					t, ok := ballotTypes[name]
+					if ok {
+						q.Type = &t
+						break
+					}
+				}
+				if q.GetType() == pb.BallotType_BALLOT_CUMULATIVE || q.GetType() == pb.BallotType_BALLOT_QUADRATIC {
+					prompt := " points each voter distributes: "
+					if q.GetType() == pb.BallotType_BALLOT_QUADRATIC {
+						prompt = " voice credits each voter has: "
+					}
+					for {
+						n, err := strconv.Atoi(ask(l, prompt))
+						if err == nil && n > 0 {
+							points := int32(n)
+							q.Points = &points
+							break
+						}
+					}
+				}
+				if q.GetType() == pb.BallotType_BALLOT_BUDGET {
+					for _, c := range q.Choices {
+						for {
+							n, err := strconv.ParseInt(ask(l, fmt.Sprintf("  cost of %s: ", c)), 10, 64)
+							if err == nil && n > 0 {
+								q.Costs = append(q.Costs, n)
+								break
+							}
+						}
+					}
+					for {
+						n, err := strconv.ParseInt(ask(l, " budget: "), 10, 64)
+						if err == nil && n > 0 {
+							q.Budget = &n
+							break
+						}
+					}
+					for {
+						name := ask(l, " funding method (greedy, equal-shares; default greedy): ")
+						if name == "" {
+							name = "greedy"
+						}
+						f, ok := fundings[name]
+						if ok {
+							q.Funding = &f
+							break
+						}
+					}
+				}
+				// Borda counts need to know all choices beforehand, and
+				// budgets their costs
+				if q.GetType() != pb.BallotType_BALLOT_BORDA && q.GetType() != pb.BallotType_BALLOT_BUDGET {
+					writeIns := strings.ToLower(ask(l, " allow write-ins (y/N): ")) == "y"
+					q.AllowWriteIns = &writeIns
+				}
+				// point-based ones carry by plurality only
+				for q.GetType() == pb.BallotType_BALLOT_SINGLE || q.GetType() == pb.BallotType_BALLOT_APPROVAL || q.GetType() == pb.BallotType_BALLOT_BUDGET {
+					name := ask(l, " threshold to carry (plurality, majority, two-thirds, absolute; default plurality): ")
+					if name == "" {
+						name = "plurality"
+					}
+					t, ok := thresholds[name]
+					if ok {
+						q.Threshold = &t
+						break
+					}
+				}
+				questions[i] = q
+			}
+
+			var runoff *durationpb.Duration
+			if nq == 1 && questions[0].GetType() == pb.BallotType_BALLOT_SINGLE && !questions[0].GetAllowWriteIns() {
+				for {
+					d := ask(l, "runoff duration if no choice gets a majority (e.g. 72h, empty for none): ")
+					if d == "" {
+						break
+					}
+					v, err := time.ParseDuration(d)
+					if err == nil && v > 0 {
+						runoff = durationpb.New(v)
+						break
+					}
+				}
+			}
+
+			var roll []*pb.RollEntry
 			for {
-				ncStr := ask(l, "number of choices: ")
-				nc, err = strconv.Atoi(ncStr)
+				include := strings.Fields(ask(l, "voters to include regardless of groups (names or @FILE, may be empty): "))
+				exclude := strings.Fields(ask(l, "voters to exclude (names or @FILE, may be empty): "))
+				roll, err = rollEntries(include, exclude)
 				if err == nil {
 					break
 				}
+				log.Println(err)
 			}
 
-			choices := make([]string, nc)
-			for i := 0; i < nc; i++ {
-				choices[i] = ask(l, " choice: ")
+			var weights []*pb.GroupWeight
+			for {
+				weights, err = groupWeights(strings.Fields(ask(l, "weights of voters by group (GROUP=WEIGHT..., may be empty): ")))
+				if err == nil {
+					break
+				}
+				log.Println(err)
+			}
+
+			revote := strings.ToLower(ask(l, "allow voters to change their votes until the end (y/N): ")) == "y"
+
+			var quorum int
+			for {
+				quorum, err = strconv.Atoi(ask(l, "quorum, in percent of the electorate (0 for none): "))
+				if err == nil && quorum >= 0 && quorum <= 100 {
+					break
+				}
 			}
+			quorum32 := int32(quorum)
 
-			status := retryWithAuth(s, func(s clientState) *pb.Status {
-				status, err := s.client.CreateElection(context.Background(), &pb.Election{
+			trustees := strings.Fields(ask(l, "trustees contributing to the beacon breaking ties (names, may be empty): "))
+			officers := strings.Fields(ask(l, "officers certifying the result (names, may be empty): "))
+
+			err := retryWithAuth(s, func(s clientState) error {
+				return pb.CreateElectionToError(s.client.CreateElection(context.Background(), &pb.Election{
 					Name: &args[1],
 					Groups: groups,
-					Choices: choices,
+					Roll: roll,
+					GroupWeights: weights,
+					AllowRevote: &revote,
+					Quorum: &quorum32,
+					Questions: questions,
+					Runoff: runoff,
+					NominationsEnd: nominationsEnd,
+					SecondsRequired: &seconds,
+					AcceptanceRequired: &acceptance,
+					Trustees: trustees,
+					Officers: officers,
 					EndDate: timestamppb.New(t),
 					Token: s.token,
-				})
-				if err != nil {
-					log.Fatalf("cannot create election: %v", err)
-				}
-				return status
-			}, func(status *pb.Status) bool {
-				return *status.Code == pb.CreateElectionUnauthn
+				}))
+			}, func(err error) bool {
+				return pb.Reason(err) == pb.ReasonCreateElectionUnauthn
 			})
-			if err = pb.CreateElectionToError(status); err != nil {
+			if err != nil {
 				log.Printf("fail to create election: %v", err)
 			}
-		case "vote":
-			if len(args) != 3 {
-				log.Println("Invalid number of arguments for vote")
+		case "vote", "abstain", "ballot":
+			if (args[0] == "vote" && len(args) != 3) || (args[0] != "vote" && len(args) != 2) {
+				log.Printf("Invalid number of arguments for %s", args[0])
 				fmt.Fprint(stdout, shellUsage)
 				break
 			}
-
-			status := retryWithAuth(s, func(s clientState) *pb.Status {
-				status, err := s.client.CastVote(context.Background(), &pb.Vote{
-					ElectionName: &args[1],
-					ChoiceName: &args[2],
-					Token: s.token,
-				})
+			vote := &pb.Vote{ElectionName: &args[1]}
+			switch args[0] {
+			case "vote":
+				vote.ChoiceName = &args[2]
+			case "abstain":
+				blank, yes := "", true
+				vote.ChoiceName, vote.Abstain = &blank, &yes
+			case "ballot":
+				e, err := s.client.GetElection(context.Background(), &pb.ElectionName{Name: &args[1]})
 				if err != nil {
-					log.Fatalf("cannot cast vote: %v", err)
+					log.Printf("fail to get election: %v", pb.ToError(err))
+					break
+				}
+				for i, q := range e.Questions {
+					if q.GetTitle() != "" {
+						fmt.Fprintf(stdout, "%d. %s\n", i + 1, *q.Title)
+					} else {
+						fmt.Fprintf(stdout, "Question %d\n", i + 1)
+					}
+					for j, c := range q.Choices {
+						if j < len(q.Costs) {
+							fmt.Fprintf(stdout, "  %s (cost %d)\n", c, q.Costs[j])
+						} else {
+							fmt.Fprintf(stdout, "  %s\n", c)
+						}
+					}
+					if q.GetAllowWriteIns() {
+						fmt.Fprintln(stdout, "  (or write in another)")
+					}
+					prompt := " choice (- to abstain, empty to skip): "
+					switch q.GetType() {
+					case pb.BallotType_BALLOT_APPROVAL:
+						prompt = " choices, separated by commas (- to abstain, empty to skip): "
+					case pb.BallotType_BALLOT_CUMULATIVE:
+						prompt = fmt.Sprintf(" CHOICE=POINTS, separated by commas, %d points at most (- to abstain, empty to skip): ", q.GetPoints())
+					case pb.BallotType_BALLOT_BORDA:
+						prompt = " choices in order of preference, separated by commas (- to abstain, empty to skip): "
+					case pb.BallotType_BALLOT_QUADRATIC:
+						prompt = fmt.Sprintf(" CHOICE=VOTES, negative against, separated by commas, n votes costing n² of %d credits (- to abstain, empty to skip): ", q.GetPoints())
+					case pb.BallotType_BALLOT_BUDGET:
+						prompt = fmt.Sprintf(" choices, separated by commas, costing %d at most (- to abstain, empty to skip): ", q.GetBudget())
+					}
+					// asked again until the answer makes sense
+					var a *pb.Answer
+					for a == nil {
+						answer := ask(l, prompt)
+						if answer == "" {
+							break
+						}
+						index := int32(i)
+						a = &pb.Answer{Question: &index}
+						if answer == "-" {
+							yes := true
+							a.Abstain = &yes
+						} else if q.GetType() == pb.BallotType_BALLOT_CUMULATIVE || q.GetType() == pb.BallotType_BALLOT_QUADRATIC {
+							for _, c := range strings.Split(answer, ",") {
+								choice, points, _ := strings.Cut(c, "=")
+								n, err := strconv.ParseInt(strings.TrimSpace(points), 10, 32)
+								if err != nil {
+									log.Printf("Invalid points for %s", strings.TrimSpace(choice))
+									a = nil
+									break
+								}
+								a.Choices = append(a.Choices, strings.TrimSpace(choice))
+								a.Points = append(a.Points, int32(n))
+							}
+						} else if q.GetType() != pb.BallotType_BALLOT_SINGLE {
+							for _, c := range strings.Split(answer, ",") {
+								a.Choices = append(a.Choices, strings.TrimSpace(c))
+							}
+						} else {
+							a.Choices = []string{answer}
+						}
+					}
+					if a != nil {
+						vote.Answers = append(vote.Answers, a)
+					}
 				}
-				return status
-			}, func(status *pb.Status) bool {
-				return *status.Code == pb.CastVoteUnauthn
+				if len(vote.Answers) == 0 {
+					log.Println("No questions answered, not casting")
+				}
+			}
+			if args[0] == "ballot" && len(vote.Answers) == 0 {
+				break
+			}
+
+			replaced := false
+			err := retryWithAuth(s, func(s clientState) error {
+				vote.Token = s.token
+				status, err := s.client.CastVote(context.Background(), vote)
+				replaced = status.GetReplaced()
+				return pb.CastVoteToError(status, err)
+			}, func(err error) bool {
+				return pb.Reason(err) == pb.ReasonCastVoteUnauthn
 			})
-			if err = pb.CastVoteToError(status); err != nil {
+			if err != nil {
 				log.Printf("fail to cast vote: %v", err)
+			} else if replaced {
+				fmt.Fprintln(stdout, "Your previous ballot was replaced")
 			}
 		case "result":
 			if len(args) != 2 {
@@ -263,17 +780,535 @@ func main() {
 				fmt.Fprint(stdout, shellUsage)
 				break
 			}
-			result, err := s.client.GetResult(context.Background(), &pb.ElectionName{Name: &args[1]})
+			result, err := pb.GetResultToError(s.client.GetResult(context.Background(), &pb.ElectionName{Name: &args[1]}))
+			if err != nil {
+				log.Printf("failed to query result: %v", err)
+			} else {
+				questions := result.Questions
+				if len(questions) == 0 {
+					// from servers without questions
+					questions = []*pb.QuestionResult{{
+						Counts: result.Counts,
+						WriteIns: result.WriteIns,
+						Abstentions: result.Abstentions,
+						AbstentionWeight: result.AbstentionWeight,
+						Winner: result.Winner,
+						Passed: result.Passed,
+					}}
+				}
+				if result.Electorate != nil && result.Turnout != nil {
+					fmt.Fprintf(stdout, "Turnout:\t%d/%d\n", *result.Turnout, *result.Electorate)
+				}
+				if result.ElectorateWeight != nil && result.TurnoutWeight != nil {
+					fmt.Fprintf(stdout, "Weighted turnout:\t%d/%d\n", *result.TurnoutWeight, *result.ElectorateWeight)
+				}
+				if result.GetProxies() != 0 {
+					fmt.Fprintf(stdout, "By proxy:\t%d (weighted %d)\n", *result.Proxies, result.GetProxyWeight())
+				}
+				for i, q := range questions {
+					if len(questions) > 1 {
+						title := q.GetTitle()
+						if title == "" {
+							title = fmt.Sprintf("Question %d", i + 1)
+						}
+						fmt.Fprintf(stdout, "\n%s\n", title)
+					}
+					for _, r := range q.Counts {
+						printCount(stdout, r, "")
+					}
+					for _, r := range q.WriteIns {
+						printCount(stdout, r, " (write-in)")
+					}
+					if q.Abstentions != nil {
+						fmt.Fprintf(stdout, "Abstentions:\t%d (weighted %d)\n", *q.Abstentions, q.GetAbstentionWeight())
+					}
+					if len(q.Funded) != 0 {
+						fmt.Fprintf(stdout, "Funded:\t%s\n", strings.Join(q.Funded, ", "))
+					}
+					if result.QuorumMet != nil {
+						switch {
+						case !*result.QuorumMet:
+							fmt.Fprintln(stdout, "Outcome:\tquorum not met")
+						case q.Winner == nil:
+							fmt.Fprintln(stdout, "Outcome:\ttie")
+						case q.GetPassed():
+							fmt.Fprintf(stdout, "Outcome:\t%s carried\n", *q.Winner)
+						default:
+							fmt.Fprintf(stdout, "Outcome:\t%s leads but did not reach the threshold\n", *q.Winner)
+						}
+					}
+					if q.GetTieBroken() {
+						fmt.Fprintf(stdout, "Tie broken in order:\t%s\n", strings.Join(q.TieBreakOrder, ", "))
+					}
+				}
+				if result.Runoff != nil {
+					fmt.Fprintf(stdout, "\nRunoff:\t%s\n", *result.Runoff)
+				}
+				if result.Beacon != nil {
+					fmt.Fprintf(stdout, "\nSeed:\t%x\n", result.Seed)
+					fmt.Fprintf(stdout, "Commitment:\t%x\n", result.Commitment)
+					for _, c := range result.Contributions {
+						fmt.Fprintf(stdout, "Contribution:\t%x by %s\n", c.Value, *c.Trustee)
+					}
+					fmt.Fprintf(stdout, "Beacon:\t%x\n", result.Beacon)
+				}
+				if result.Certificate != nil {
+					fmt.Fprintf(stdout, "\nCertificate:\t%x (SHA-256)\n", sha256.Sum256(result.Certificate))
+					m := sodium.Bytes(result.Certificate)
+					if m.SignVerifyDetached(sodium.Signature{Bytes: result.Signature}, sodium.SignPublicKey{Bytes: result.ServerKey}) != nil {
+						fmt.Fprintln(stdout, "Server signature:\tinvalid")
+					} else {
+						fmt.Fprintf(stdout, "Signed by server key:\t%x\n", result.ServerKey)
+					}
+					for _, c := range result.Countersignatures {
+						fmt.Fprintf(stdout, "Countersigned by:\t%s\n", *c.Officer)
+					}
+				}
+			}
+		case "adjudicate":
+			if len(args) != 2 {
+				log.Println("Invalid number of arguments for adjudicate")
+				fmt.Fprint(stdout, shellUsage)
+				break
+			}
+			result, err := pb.GetResultToError(s.client.GetResult(context.Background(), &pb.ElectionName{Name: &args[1]}))
 			if err != nil {
-				log.Fatalf("cannot query result: %v", err)
+				log.Printf("failed to query result: %v", err)
+				break
+			}
+			for i, q := range result.Questions {
+				if len(q.WriteIns) == 0 {
+					continue
+				}
+				if q.GetTitle() != "" {
+					fmt.Fprintf(stdout, "%d. %s\n", i + 1, *q.Title)
+				} else {
+					fmt.Fprintf(stdout, "Question %d\n", i + 1)
+				}
+				merges := []*pb.WriteInMerge{}
+				for _, r := range q.WriteIns {
+					printCount(stdout, r, "")
+					choice := ask(l, " merge into (empty to keep): ")
+					if choice == "" {
+						continue
+					}
+					// along with what was merged into it
+					for _, w := range append([]string{*r.ChoiceName}, r.Merged...) {
+						w := w
+						merges = append(merges, &pb.WriteInMerge{WriteIn: &w, Choice: &choice})
+					}
+				}
+				if len(merges) == 0 {
+					continue
+				}
+				index := int32(i)
+				err := retryWithAuth(s, func(s clientState) error {
+					return pb.AdjudicateWriteInsToError(s.client.AdjudicateWriteIns(context.Background(), &pb.WriteInAdjudication{
+						ElectionName: &args[1],
+						Question: &index,
+						Merges: merges,
+						Token: s.token,
+					}))
+				}, func(err error) bool {
+					return pb.Reason(err) == pb.ReasonAdjudicateWriteInsUnauthn
+				})
+				if err != nil {
+					log.Printf("fail to adjudicate write-ins: %v", err)
+				}
+			}
+		case "list":
+			req := &pb.ListElectionsRequest{}
+			valid, yes := true, true
+			for _, arg := range args[1:] {
+				switch arg {
+				case "open":
+					req.State = pb.ElectionState_ELECTION_OPEN.Enum()
+				case "closed":
+					req.State = pb.ElectionState_ELECTION_CLOSED.Enum()
+				case "eligible":
+					req.Eligible = &yes
+				case "mine":
+					req.Mine = &yes
+				default:
+					valid = false
+				}
+			}
+			if !valid {
+				log.Println("Invalid arguments for list")
+				fmt.Fprint(stdout, shellUsage)
+				break
+			}
+
+			w := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tSTATE\tENDS\tGROUPS")
+			for {
+				list, err := retryWithAuth(s, func(s clientState) listResult {
+					req.Token = s.token
+					list, err := s.client.ListElections(context.Background(), req)
+					return listResult{list, pb.ToError(err)}
+				}, func(r listResult) bool {
+					return pb.Reason(r.err) == pb.ReasonListElectionsUnauthn
+				}).unpack()
+				if err != nil {
+					log.Printf("fail to list elections: %v", err)
+					break
+				}
+				for _, e := range list.Elections {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", *e.Name, stateString(*e.State),
+						e.EndDate.AsTime().Local().Format(time.DateTime), strings.Join(e.Groups, ","))
+				}
+				if list.GetNextPageToken() == "" {
+					break
+				}
+				req.PageToken = list.NextPageToken
+			}
+			w.Flush()
+		case "show":
+			if len(args) != 2 {
+				log.Println("Invalid number of arguments for show")
+				fmt.Fprint(stdout, shellUsage)
+				break
+			}
+			e, err := s.client.GetElection(context.Background(), &pb.ElectionName{Name: &args[1]})
+			if err != nil {
+				log.Printf("fail to show election: %v", pb.ToError(err))
+				break
+			}
+			fmt.Fprintf(stdout, "Name:\t%s\n", *e.Name)
+			fmt.Fprintf(stdout, "State:\t%s\n", stateString(*e.State))
+			if e.Creator != nil {
+				fmt.Fprintf(stdout, "Creator:\t%s\n", *e.Creator)
+			}
+			if e.StartDate != nil {
+				fmt.Fprintf(stdout, "Starts:\t%s\n", e.StartDate.AsTime().Local().Format(time.DateTime))
+			}
+			fmt.Fprintf(stdout, "Ends:\t%s\n", e.EndDate.AsTime().Local().Format(time.DateTime))
+			fmt.Fprintf(stdout, "Groups:\t%s\n", strings.Join(e.Groups, ", "))
+			for _, w := range e.GroupWeights {
+				fmt.Fprintf(stdout, "Weight of %s:\t%d\n", *w.Group, *w.Weight)
+			}
+			if e.Electorate != nil {
+				fmt.Fprintf(stdout, "Electorate:\t%d voters as of %s\n", *e.Electorate, e.SnapshotDate.AsTime().Local().Format(time.DateTime))
+			}
+			if e.ElectorateWeight != nil {
+				fmt.Fprintf(stdout, "Electorate weight:\t%d\n", *e.ElectorateWeight)
+			}
+			if e.GetAllowRevote() {
+				fmt.Fprintln(stdout, "Voters may change their votes until the end")
+			}
+			if e.Quorum != nil {
+				fmt.Fprintf(stdout, "Quorum:\t%d%%\n", *e.Quorum)
+			}
+			if e.NominationsEnd != nil {
+				fmt.Fprintf(stdout, "Nominations until:\t%s\n", e.NominationsEnd.AsTime().Local().Format(time.DateTime))
+				fmt.Fprintf(stdout, "Seconds needed:\t%d\n", e.GetSecondsRequired())
+				if e.GetAcceptanceRequired() {
+					fmt.Fprintln(stdout, "Nominees must accept")
+				}
+			}
+			if e.Commitment != nil {
+				fmt.Fprintf(stdout, "Beacon commitment:\t%x\n", e.Commitment)
+			}
+			if len(e.Trustees) != 0 {
+				fmt.Fprintf(stdout, "Trustees:\t%s\n", strings.Join(e.Trustees, ", "))
+			}
+			if len(e.Officers) != 0 {
+				fmt.Fprintf(stdout, "Certifying officers:\t%s\n", strings.Join(e.Officers, ", "))
+			}
+			if e.FirstRound != nil {
+				fmt.Fprintf(stdout, "Runoff of:\t%s\n", *e.FirstRound)
+			}
+			if e.RunoffElection != nil {
+				fmt.Fprintf(stdout, "Runoff:\t%s\n", *e.RunoffElection)
+			} else if e.Runoff != nil {
+				fmt.Fprintf(stdout, "Runoff:\tfor %s if no choice gets a majority\n", e.Runoff.AsDuration())
+			}
+			questions := e.Questions
+			if len(questions) == 0 {
+				// from servers without questions
+				questions = []*pb.Question{{Choices: e.Choices, Threshold: e.Threshold}}
+			}
+			for i, q := range questions {
+				if len(questions) > 1 {
+					title := q.GetTitle()
+					if title == "" {
+						title = fmt.Sprintf("Question %d", i + 1)
+					}
+					fmt.Fprintf(stdout, "%d. %s\n", i + 1, title)
+				}
+				fmt.Fprintf(stdout, "Ballot type:\t%s\n", ballotTypeString(q.GetType()))
+				if q.Points != nil && q.GetType() == pb.BallotType_BALLOT_QUADRATIC {
+					fmt.Fprintf(stdout, "Voice credits:\t%d each voter\n", *q.Points)
+				} else if q.Points != nil {
+					fmt.Fprintf(stdout, "Points:\t%d each voter\n", *q.Points)
+				}
+				if q.Budget != nil {
+					fmt.Fprintf(stdout, "Budget:\t%d, funded by %s\n", *q.Budget, fundingString(q.GetFunding()))
+				}
+				if q.GetAllowWriteIns() {
+					fmt.Fprintln(stdout, "Write-ins allowed")
+				}
+				fmt.Fprintf(stdout, "Threshold:\t%s\n", thresholdString(q.GetThreshold()))
+				fmt.Fprintln(stdout, "Choices:")
+				for j, c := range q.Choices {
+					if j < len(q.Costs) {
+						fmt.Fprintf(stdout, "  %s\t(cost %d)\n", c, q.Costs[j])
+					} else {
+						fmt.Fprintf(stdout, "  %s\n", c)
+					}
+				}
+			}
+		case "roll":
+			if len(args) < 4 {
+				log.Println("Invalid number of arguments for roll")
+				fmt.Fprint(stdout, shellUsage)
+				break
+			}
+			names, err := expandNames(args[3:])
+			if err != nil {
+				log.Println(err)
+				break
+			}
+			yes := true
+			req := &pb.UpdateRollRequest{ElectionName: &args[1], Snapshot: &yes}
+			switch args[2] {
+			case "include":
+				req.Add, _ = rollEntries(names, nil)
+			case "exclude":
+				req.Add, _ = rollEntries(nil, names)
+			case "remove":
+				req.Remove = names
+			default:
+				log.Println("Invalid action for roll")
+				fmt.Fprint(stdout, shellUsage)
+				continue
+			}
+
+			err = retryWithAuth(s, func(s clientState) error {
+				req.Token = s.token
+				return pb.UpdateRollToError(s.client.UpdateRoll(context.Background(), req))
+			}, func(err error) bool {
+				return pb.Reason(err) == pb.ReasonUpdateRollUnauthn
+			})
+			if err != nil {
+				log.Printf("fail to update roll: %v", err)
+			}
+		case "snapshot":
+			if len(args) != 2 {
+				log.Println("Invalid number of arguments for snapshot")
+				fmt.Fprint(stdout, shellUsage)
+				break
+			}
+			err := retryWithAuth(s, func(s clientState) error {
+				return pb.SnapshotElectorateToError(s.client.SnapshotElectorate(context.Background(), &pb.ElectionRequest{
+					ElectionName: &args[1],
+					Token: s.token,
+				}))
+			}, func(err error) bool {
+				return pb.Reason(err) == pb.ReasonSnapshotUnauthn
+			})
+			if err != nil {
+				log.Printf("fail to freeze electorate: %v", err)
+			}
+		case "contribute":
+			if len(args) != 2 {
+				log.Println("Invalid number of arguments for contribute")
+				fmt.Fprint(stdout, shellUsage)
+				break
+			}
+			value := make([]byte, 32)
+			_, err := rand.Read(value)
+			if err != nil {
+				log.Printf("fail to generate contribution: %v", err)
+				break
+			}
+			err = retryWithAuth(s, func(s clientState) error {
+				return pb.ContributeToBeaconToError(s.client.ContributeToBeacon(context.Background(), &pb.BeaconContribution{
+					ElectionName: &args[1],
+					Value: value,
+					Token: s.token,
+				}))
+			}, func(err error) bool {
+				return pb.Reason(err) == pb.ReasonContributeToBeaconUnauthn
+			})
+			if err != nil {
+				log.Printf("fail to contribute: %v", err)
+			} else {
+				fmt.Fprintf(stdout, "Contributed %x\n", value)
+			}
+		case "turnout":
+			if len(args) != 2 && len(args) != 3 {
+				log.Println("Invalid number of arguments for turnout")
+				fmt.Fprint(stdout, shellUsage)
+				break
+			}
+			req := &pb.TurnoutRequest{ElectionName: &args[1]}
+			if len(args) == 3 {
+				d, err := time.ParseDuration(args[2])
+				if err != nil {
+					log.Printf("Invalid interval: %v", err)
+					break
+				}
+				req.Interval = durationpb.New(d)
+			}
+			stats, err := retryWithAuth(s, func(s clientState) turnoutResult {
+				req.Token = s.token
+				stats, err := s.client.GetTurnout(context.Background(), req)
+				return turnoutResult{stats, pb.ToError(err)}
+			}, func(r turnoutResult) bool {
+				return pb.Reason(r.err) == pb.ReasonGetTurnoutUnauthn
+			}).unpack()
+			if err != nil {
+				log.Printf("fail to query turnout: %v", err)
+				break
+			}
+			fmt.Fprintf(stdout, "Turnout:\t%d/%d\n", stats.GetTurnout(), stats.GetElectorate())
+			fmt.Fprintf(stdout, "Weighted turnout:\t%d/%d\n", stats.GetTurnoutWeight(), stats.GetElectorateWeight())
+			w := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+			if len(stats.Groups) != 0 {
+				fmt.Fprintln(w, "\nGROUP\tTURNOUT\tWEIGHTED")
+				for _, g := range stats.Groups {
+					fmt.Fprintf(w, "%s\t%d/%d\t%d/%d\n", *g.Group, g.GetTurnout(), g.GetElectorate(), g.GetTurnoutWeight(), g.GetElectorateWeight())
+				}
+			}
+			if len(stats.Timeline) != 0 {
+				fmt.Fprintln(w, "\nUNTIL\tTURNOUT\tWEIGHTED")
+				for _, t := range stats.Timeline {
+					fmt.Fprintf(w, "%s\t%d\t%d\n", t.Until.AsTime().Local().Format(time.DateTime), t.GetTurnout(), t.GetTurnoutWeight())
+				}
+			}
+			w.Flush()
+		case "watch":
+			if len(args) != 2 {
+				log.Println("Invalid number of arguments for watch")
+				fmt.Fprint(stdout, shellUsage)
+				break
+			}
+			if !watching[args[1]] {
+				watching[args[1]] = true
+				go watch(s, args[1], stdout)
+			}
+		case "certify":
+			if len(args) != 2 {
+				log.Println("Invalid number of arguments for certify")
+				fmt.Fprint(stdout, shellUsage)
+				break
 			}
-			result, err = pb.GetResultToError(result)
+			result, err := pb.GetResultToError(s.client.GetResult(context.Background(), &pb.ElectionName{Name: &args[1]}))
 			if err != nil {
 				log.Printf("failed to query result: %v", err)
+				break
+			}
+			if result.Certificate == nil {
+				log.Println("Server did not issue a result document")
+				break
+			}
+			m := sodium.Bytes(result.Certificate)
+			sig := m.SignDetached(s.key)
+			err = retryWithAuth(s, func(s clientState) error {
+				return pb.CertifyResultToError(s.client.CertifyResult(context.Background(), &pb.ResultCertification{
+					ElectionName: &args[1],
+					Signature: sig.Bytes,
+					Token: s.token,
+				}))
+			}, func(err error) bool {
+				return pb.Reason(err) == pb.ReasonCertifyResultUnauthn
+			})
+			if err != nil {
+				log.Printf("fail to certify result: %v", err)
 			} else {
-				for _, r := range result.Counts {
-					fmt.Fprintf(stdout, "%s:\t%d\n", *r.ChoiceName, *r.Count)
+				fmt.Fprintf(stdout, "Certified %x\n", sha256.Sum256(result.Certificate))
+			}
+		case "nominate", "second", "accept", "decline":
+			if (args[0] == "nominate" || args[0] == "second") && len(args) != 3 ||
+				(args[0] == "accept" || args[0] == "decline") && len(args) != 2 {
+				log.Printf("Invalid number of arguments for %s", args[0])
+				fmt.Fprint(stdout, shellUsage)
+				break
+			}
+			err := retryWithAuth(s, func(s clientState) error {
+				switch args[0] {
+				case "nominate":
+					return pb.NominationToError(s.client.Nominate(context.Background(), &pb.NominationRequest{
+						ElectionName: &args[1],
+						Nominee: &args[2],
+						Token: s.token,
+					}))
+				case "second":
+					return pb.NominationToError(s.client.SecondNomination(context.Background(), &pb.NominationRequest{
+						ElectionName: &args[1],
+						Nominee: &args[2],
+						Token: s.token,
+					}))
+				}
+				accept := args[0] == "accept"
+				return pb.NominationToError(s.client.RespondNomination(context.Background(), &pb.NominationResponse{
+					ElectionName: &args[1],
+					Accept: &accept,
+					Token: s.token,
+				}))
+			}, func(err error) bool {
+				switch pb.Reason(err) {
+				case pb.ReasonNominateUnauthn, pb.ReasonSecondNominationUnauthn, pb.ReasonRespondNominationUnauthn:
+					return true
+				}
+				return false
+			})
+			if err != nil {
+				log.Printf("fail to %s: %v", args[0], err)
+			}
+		case "nominations":
+			if len(args) != 2 {
+				log.Println("Invalid number of arguments for nominations")
+				fmt.Fprint(stdout, shellUsage)
+				break
+			}
+			list, err := s.client.ListNominations(context.Background(), &pb.ElectionName{Name: &args[1]})
+			if err != nil {
+				log.Printf("fail to list nominations: %v", pb.ToError(err))
+				break
+			}
+			w := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+			fmt.Fprintln(w, "NOMINEE\tNOMINATOR\tSECONDS\tACCEPTED\tQUALIFIED")
+			for _, n := range list.Nominations {
+				accepted, qualified := "pending", "no"
+				if n.Accepted != nil && *n.Accepted {
+					accepted = "yes"
+				} else if n.Accepted != nil {
+					accepted = "declined"
 				}
+				if n.GetQualified() {
+					qualified = "yes"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", *n.Nominee, n.GetNominator(), n.GetSeconds(), accepted, qualified)
+			}
+			w.Flush()
+		case "delegate", "revoke":
+			if (args[0] == "delegate" && len(args) != 4) || (args[0] == "revoke" && len(args) != 3) ||
+				(args[1] != "election" && args[1] != "group") {
+				log.Printf("Invalid arguments for %s", args[0])
+				fmt.Fprint(stdout, shellUsage)
+				break
+			}
+			err := retryWithAuth(s, func(s clientState) error {
+				scope := &pb.DelegationScope{Token: s.token}
+				if args[1] == "election" {
+					scope.ElectionName = &args[2]
+				} else {
+					scope.Group = &args[2]
+				}
+				if args[0] == "delegate" {
+					return pb.DelegateToError(s.client.Delegate(context.Background(), &pb.DelegateRequest{
+						Scope: scope,
+						Delegate: &args[3],
+					}))
+				}
+				return pb.DelegateToError(s.client.RevokeDelegation(context.Background(), scope))
+			}, func(err error) bool {
+				return pb.Reason(err) == pb.ReasonDelegateUnauthn || pb.Reason(err) == pb.ReasonRevokeDelegationUnauthn
+			})
+			if err != nil {
+				log.Printf("fail to %s: %v", args[0], err)
 			}
 		case "#":
 		default:
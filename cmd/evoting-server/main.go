@@ -3,17 +3,23 @@ package main
 import (
 	"context"
 	"crypto/rand"
-	"database/sql"
+	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"flag"
+	"io"
 	"log"
+	"math/big"
 	"net"
 	"os"
 	"os/exec"
 	"path"
+	"runtime/debug"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/jamesruan/sodium"
@@ -22,8 +28,8 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/status"
-	_ "modernc.org/sqlite"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -32,367 +38,2172 @@ var (
 	syncAddr	= flag.String("sync-listen", "0.0.0.0:5679", "Listen address for syncing")
 	primaryAddr	= flag.String("join-primary", "", "Join a primary for primary-backup setup")
 	primaryAction	= flag.String("set-primary", "", "Shell command for setting up networking as a primary")
+	postgresDSN	= flag.String("postgres", "", "PostgreSQL connection string, use the built-in SQLite database if empty")
 	primary	string	= ""
 	nodes	[]string	= []string{}
-	dbPath	= ""
+	// nil if the backend replicates by itself
+	replicated	store.Replicable
 )
 
 const (
-	dbSchema = `CREATE TABLE IF NOT EXISTS 'users' ('name' TEXT PRIMARY KEY, 'group' TEXT);
-CREATE TABLE IF NOT EXISTS 'challenges' ('id' INTEGER PRIMARY KEY AUTOINCREMENT, 'name' TEXT, 'value' TEXT);
-CREATE TABLE IF NOT EXISTS 'elections' ('id' INTEGER PRIMARY KEY AUTOINCREMENT, 'name' TEXT UNIQUE, 'end_date' TEXT);
-CREATE TABLE IF NOT EXISTS 'election_groups' ('id' INTEGER PRIMARY KEY AUTOINCREMENT, 'election_id' INTEGER, 'group' TEXT, FOREIGN KEY('election_id') REFERENCES elections('id'));
-CREATE TABLE IF NOT EXISTS 'election_choices' ('id' INTEGER PRIMARY KEY AUTOINCREMENT, 'election_id' INTEGER, 'choice' TEXT, 'votes' INTEGER DEFAULT 0, FOREIGN KEY('election_id') REFERENCES elections('id'));
-CREATE TABLE IF NOT EXISTS 'election_voted' ('id' INTEGER PRIMARY KEY AUTOINCREMENT, 'election_id' INTEGER, 'user' TEXT, FOREIGN KEY('election_id') REFERENCES elections('id'))`
-	dbReset = `PRAGMA writable_schema = 1;DELETE FROM sqlite_master;PRAGMA writable_schema = 0;VACUUM;PRAGMA integrity_check;`
 	challengeBytes = 16
+	seedBytes = 32
+	defaultPageSize = 20
+	maxPageSize = 100
 )
 
 type registrationServer struct {
 	pb.UnimplementedRegistrationServer
-	keysDir string
-	db *sql.DB
+	db store.Store
+}
+
+// validGroup tells if g is a well-formed group path, like engineering/backend.
+func validGroup(g string) bool {
+	for _, part := range strings.Split(g, "/") {
+		if part == "" {
+			return false
+		}
+	}
+	return true
 }
 
 func (s registrationServer) RegisterVoter(_ context.Context, v *pb.Voter) (*pb.Status, error) {
+	groups := append([]string{*v.Group}, v.Groups...)
+	for _, g := range groups {
+		if !validGroup(g) {
+			return nil, pb.NewError(codes.InvalidArgument, pb.ReasonRegisterVoterBadGroup)
+		}
+	}
+	if len(v.PublicKey) != (sodium.SignPublicKey{}).Size() {
+		return nil, pb.NewError(codes.InvalidArgument, pb.ReasonRegisterVoterBadKey)
+	}
+	if v.Weight != nil && *v.Weight <= 0 {
+		return nil, pb.NewError(codes.InvalidArgument, pb.ReasonRegisterVoterBadWeight)
+	}
+	err := s.db.AddVoter(store.Voter{Name: *v.Name, Groups: groups, Weight: v.GetWeight()})
+	if errors.Is(err, store.ErrExists) {
+		return nil, pb.NewError(codes.AlreadyExists, pb.ReasonRegisterVoterExists)
+	}
+	if err != nil {
+		return nil, internalError(err)
+	}
+	err = s.putKeys([]*pb.Key{{Name: v.Name, Key: v.PublicKey}})
+	if err != nil {
+		return nil, internalError(err)
+	}
+	syncToBackups()
+	syncKeyToBackups(*v.Name, v.PublicKey)
 	status := pb.RegisterVoterSuccess
-	_, err := s.db.Exec("INSERT INTO 'users' ('name', 'group') VALUES ($1, $2)", v.Name, v.Group)
+	return &pb.Status{Code: &status}, nil
+}
+
+// putKeys stores keys of voters just added, removing those voters again if
+// it fails, so that none is left registered without a key.
+func (s registrationServer) putKeys(keys []*pb.Key) error {
+	for i, k := range keys {
+		err := s.db.PutKey(*k.Name, k.Key)
+		if err == nil {
+			continue
+		}
+		for _, k := range keys[:i] {
+			s.db.RemoveKey(*k.Name)
+		}
+		for _, k := range keys {
+			s.db.RemoveVoter(*k.Name)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s registrationServer) UnregisterVoter(_ context.Context, v *pb.VoterName) (*pb.Status, error) {
+	err := s.db.RemoveVoter(*v.Name)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, pb.NewError(codes.NotFound, pb.ReasonUnregisterVoterNotFound)
+	}
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	s.db.RemoveKey(*v.Name)
+	syncToBackups()
+	status := pb.UnregisterVoterSuccess
+	return &pb.Status{Code: &status}, nil
+}
+
+func (s registrationServer) RegisterVoters(stream pb.Registration_RegisterVotersServer) error {
+	var (
+		voters []store.Voter
+		keys []*pb.Key
+		reasons []string
+		dryRun bool
+	)
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if len(voters) == 0 {
+			dryRun = req.GetDryRun()
+		}
+
+		v := req.Voter
+		groups := append([]string{*v.Group}, v.Groups...)
+		reason := ""
+		for _, g := range groups {
+			if !validGroup(g) {
+				reason = pb.ReasonRegisterVoterBadGroup
+			}
+		}
+		if len(v.PublicKey) != (sodium.SignPublicKey{}).Size() {
+			reason = pb.ReasonRegisterVoterBadKey
+		}
+		if v.Weight != nil && *v.Weight <= 0 {
+			reason = pb.ReasonRegisterVoterBadWeight
+		}
+		voters = append(voters, store.Voter{Name: *v.Name, Groups: groups, Weight: v.GetWeight()})
+		keys = append(keys, &pb.Key{Name: v.Name, Key: v.PublicKey})
+		reasons = append(reasons, reason)
+	}
+
+	failed := false
+	for _, r := range reasons {
+		failed = failed || r != ""
+	}
+	// still look for duplicates if some are already known to be bad
+	errs, err := s.db.AddVoters(voters, dryRun || failed)
+	if err != nil {
+		return storeError(err)
+	}
+	res := &pb.RegisterVotersResult{}
+	for i := range voters {
+		if reasons[i] == "" && errors.Is(errs[i], store.ErrExists) {
+			reasons[i] = pb.ReasonRegisterVoterExists
+		}
+		if reasons[i] != "" {
+			index := int32(i)
+			res.Errors = append(res.Errors, &pb.VoterError{
+				Index: &index,
+				Name: &voters[i].Name,
+				Reason: &reasons[i],
+			})
+		}
+	}
+	registered := int32(0)
+	if len(res.Errors) == 0 {
+		registered = int32(len(voters))
+	}
+	res.Registered = &registered
+	if len(res.Errors) != 0 || dryRun {
+		return stream.SendAndClose(res)
+	}
+
+	err = s.putKeys(keys)
+	if err != nil {
+		return internalError(err)
+	}
+	syncToBackups()
+	syncKeysToBackups(keys)
+	return stream.SendAndClose(res)
+}
+
+func (s registrationServer) AddMembership(_ context.Context, m *pb.Membership) (*pb.Status, error) {
+	if !validGroup(*m.Group) {
+		return nil, pb.NewError(codes.InvalidArgument, pb.ReasonAddMembershipBadGroup)
+	}
+	err := s.db.AddMembership(*m.Name, *m.Group)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, pb.NewError(codes.NotFound, pb.ReasonAddMembershipNotFound)
+	}
+	if errors.Is(err, store.ErrExists) {
+		return nil, pb.NewError(codes.AlreadyExists, pb.ReasonAddMembershipExists)
+	}
+	if err != nil {
+		return nil, internalError(err)
+	}
+	syncToBackups()
+	status := pb.MembershipSuccess
+	return &pb.Status{Code: &status}, nil
+}
+
+func (s registrationServer) RemoveMembership(_ context.Context, m *pb.Membership) (*pb.Status, error) {
+	err := s.db.RemoveMembership(*m.Name, *m.Group)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, pb.NewError(codes.NotFound, pb.ReasonRemoveMembershipNotFound)
+	}
+	if err != nil {
+		return nil, internalError(err)
+	}
+	syncToBackups()
+	status := pb.MembershipSuccess
+	return &pb.Status{Code: &status}, nil
+}
+
+func (s registrationServer) UpdateVoter(_ context.Context, v *pb.VoterUpdate) (*pb.Status, error) {
+	for _, g := range v.Groups {
+		if !validGroup(g) {
+			return nil, pb.NewError(codes.InvalidArgument, pb.ReasonUpdateVoterBadGroup)
+		}
+	}
+	if v.Weight != nil && *v.Weight <= 0 {
+		return nil, pb.NewError(codes.InvalidArgument, pb.ReasonUpdateVoterBadWeight)
+	}
+	if v.PublicKey != nil && len(v.PublicKey) != (sodium.SignPublicKey{}).Size() {
+		return nil, pb.NewError(codes.InvalidArgument, pb.ReasonUpdateVoterBadKey)
+	}
+	err := s.db.UpdateVoter(*v.Name, v.Groups, v.GetWeight())
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, pb.NewError(codes.NotFound, pb.ReasonUpdateVoterNotFound)
+	}
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if len(v.Groups) > 0 || v.Weight != nil {
+		syncToBackups()
+	}
+
+	if v.PublicKey != nil {
+		err = s.db.PutKey(*v.Name, v.PublicKey)
+		if err != nil {
+			return nil, internalError(err)
+		}
+		syncKeyToBackups(*v.Name, v.PublicKey)
+	}
+	status := pb.UpdateVoterSuccess
+	return &pb.Status{Code: &status}, nil
+}
+
+func (s registrationServer) voterInfo(v store.Voter) (*pb.VoterInfo, error) {
+	name, weight := v.Name, v.Weight
+	info := &pb.VoterInfo{Name: &name, Groups: v.Groups, Weight: &weight}
+	key, err := s.db.GetKey(v.Name)
+	if err != nil && !errors.Is(err, store.ErrNotFound) {
+		return nil, err
+	}
+	info.PublicKey = key
+	return info, nil
+}
+
+func (s registrationServer) GetVoter(_ context.Context, v *pb.VoterName) (*pb.VoterInfo, error) {
+	voter, err := s.db.GetVoter(*v.Name)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, pb.NewError(codes.NotFound, pb.ReasonGetVoterNotFound)
+	}
+	if err != nil {
+		return nil, internalError(err)
+	}
+	info, err := s.voterInfo(voter)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	return info, nil
+}
+
+func (s registrationServer) ListVoters(_ context.Context, req *pb.ListVotersRequest) (*pb.VoterList, error) {
+	filter := store.VoterFilter{
+		Limit: defaultPageSize,
+		After: req.GetPageToken(),
+		Group: req.GetGroup(),
+	}
+	if req.PageSize != nil && *req.PageSize > 0 && *req.PageSize <= maxPageSize {
+		filter.Limit = int(*req.PageSize)
+	}
+
+	// one more to tell if there is a next page
+	pageSize := filter.Limit
+	filter.Limit++
+	voters, err := s.db.ListVoters(filter)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	res := &pb.VoterList{}
+	if len(voters) > pageSize {
+		voters = voters[:pageSize]
+		next := voters[pageSize - 1].Name
+		res.NextPageToken = &next
+	}
+	for _, v := range voters {
+		info, err := s.voterInfo(v)
+		if err != nil {
+			return nil, internalError(err)
+		}
+		res.Voters = append(res.Voters, info)
+	}
+	return res, nil
+}
+
+type eVotingServer struct {
+	pb.UnimplementedEVotingServer
+	db store.Store
+	key sodium.SignKP
+}
+
+func (s eVotingServer) PreAuth(_ context.Context, name *pb.VoterName) (*pb.Challenge, error) {
+	_, err := s.db.GetVoter(*name.Name)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, pb.NewError(codes.Unauthenticated, pb.ReasonAuthNotRegistered)
+	}
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	var c [challengeBytes]byte
+	_, err = rand.Read(c[:])
+	if err != nil {
+		return nil, internalError(err)
+	}
+	var challenge [challengeBytes * 2]byte
+	hex.Encode(challenge[:], c[:])
+	err = s.db.AddChallenge(*name.Name, string(challenge[:]))
+	if err != nil {
+		return nil, internalError(err)
+	}
+	syncToBackups()
+
+	return &pb.Challenge{Value: challenge[:]}, nil
+}
+
+// internalError logs err and returns a status that doesn't leak it.
+func internalError(err error) error {
+	log.Printf("internal error: %v", err)
+	return pb.NewError(codes.Internal, pb.ReasonInternal)
+}
+
+// storeError is internalError, except for store.ErrConflict which is worth
+// retrying by clients.
+func storeError(err error) error {
+	if errors.Is(err, store.ErrConflict) {
+		return pb.NewError(codes.Aborted, pb.ReasonConflict)
+	}
+	return internalError(err)
+}
+
+// recoverUnary turns panics in handlers into Internal errors instead of
+// taking the whole server down.
+func recoverUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("panic in %s: %v\n%s", info.FullMethod, r, debug.Stack())
+			err = pb.NewError(codes.Internal, pb.ReasonInternal)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+func recoverStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("panic in %s: %v\n%s", info.FullMethod, r, debug.Stack())
+			err = pb.NewError(codes.Internal, pb.ReasonInternal)
+		}
+	}()
+	return handler(srv, ss)
+}
+
+func newServer() *grpc.Server {
+	return grpc.NewServer(grpc.UnaryInterceptor(recoverUnary), grpc.StreamInterceptor(recoverStream))
+}
+
+type token struct {
+	Sub	string
+	Exp	time.Time
+}
+
+func (s eVotingServer) Auth(_ context.Context, req *pb.AuthRequest) (*pb.AuthToken, error) {
+	b, err := s.db.GetKey(*req.Name.Name)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, pb.NewError(codes.Unauthenticated, pb.ReasonAuthNotRegistered)
+	}
+	if err != nil {
+		return nil, internalError(err)
+	}
+	key := sodium.SignPublicKey{Bytes: sodium.Bytes(b)}
+	challenges, err := s.db.Challenges(*req.Name.Name)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	for _, c := range challenges {
+		m := sodium.Bytes([]byte(c))
+		err = m.SignVerifyDetached(sodium.Signature{Bytes: req.Response.Value}, key)
+		if err == nil {
+			s.db.RemoveChallenge(c)
+			syncToBackups()
+			j, _ := json.Marshal(token{Sub: *req.Name.Name, Exp: time.Now().Add(time.Hour)})
+			tok := sodium.Bytes(j)
+			token := tok.Sign(s.key.SecretKey)
+			return &pb.AuthToken{Value: token}, nil
+		}
+	}
+
+	return nil, pb.NewError(codes.Unauthenticated, pb.ReasonAuthBadSignature)
+}
+
+func (s eVotingServer) verifyToken(t *pb.AuthToken) (string, error) {
+	m := sodium.Bytes(t.Value)
+	b, err := m.SignOpen(s.key.PublicKey)
+	if err != nil {
+		log.Println("invalid signature")
+		return "", err
+	}
+	var token token
+	err = json.Unmarshal(b, &token)
+	if err != nil {
+		return "", err
+	}
+	if time.Now().Before(token.Exp) {
+		return token.Sub, nil
+	}
+	return "", errors.New("token expired")
+}
+
+func (s eVotingServer) CreateElection(_ context.Context, e *pb.Election) (*pb.Status, error) {
+	user, err := s.verifyToken(e.Token)
+	if err != nil {
+		return nil, pb.NewError(codes.Unauthenticated, pb.ReasonCreateElectionUnauthn)
+	}
+
+	roll := rollEntries(e.Roll)
+	included := false
+	for _, r := range roll {
+		included = included || !r.Exclude
+	}
+	nominating := e.NominationsEnd != nil
+	if len(e.Choices) != 0 && len(e.Questions) != 0 || len(e.Choices) == 0 && len(e.Questions) == 0 && !nominating ||
+		(len(e.Groups) == 0 && !included) {
+		return nil, pb.NewError(codes.InvalidArgument, pb.ReasonCreateElectionNoSpec)
+	}
+	now := time.Now()
+	var nominationsEnd time.Time
+	if nominating {
+		nominationsEnd = e.NominationsEnd.AsTime()
+		if !now.Before(nominationsEnd) || !nominationsEnd.Before(e.EndDate.AsTime()) || e.GetSecondsRequired() < 0 {
+			return nil, pb.NewError(codes.InvalidArgument, pb.ReasonCreateElectionBadNominations)
+		}
+	}
+	if e.GetQuorum() < 0 || e.GetQuorum() > 100 {
+		return nil, pb.NewError(codes.InvalidArgument, pb.ReasonCreateElectionBadQuorum)
+	}
+	specs := e.Questions
+	if len(e.Questions) == 0 {
+		specs = []*pb.Question{{Choices: e.Choices, Threshold: e.Threshold, AllowWriteIns: e.AllowWriteIns}}
+	}
+	questions := []store.Question{}
+	for i, q := range specs {
+		if _, ok := pb.Threshold_name[int32(q.GetThreshold())]; !ok {
+			return nil, pb.NewError(codes.InvalidArgument, pb.ReasonCreateElectionBadThreshold)
+		}
+		// nominees are added to the first one
+		if _, ok := pb.BallotType_name[int32(q.GetType())]; !ok || len(q.Choices) == 0 && (i != 0 || !nominating) {
+			return nil, pb.NewError(codes.InvalidArgument, pb.ReasonCreateElectionBadQuestion)
+		}
+		seen := map[string]bool{}
+		for _, c := range q.Choices {
+			if seen[c] {
+				return nil, pb.NewError(codes.InvalidArgument, pb.ReasonCreateElectionBadQuestion)
+			}
+			seen[c] = true
+		}
+		// Borda counts need to know all choices beforehand
+		if pointBased(q.GetType()) && q.GetThreshold() != pb.Threshold_THRESHOLD_PLURALITY ||
+			(q.GetType() == pb.BallotType_BALLOT_CUMULATIVE || q.GetType() == pb.BallotType_BALLOT_QUADRATIC) && q.GetPoints() <= 0 ||
+			q.GetType() == pb.BallotType_BALLOT_BORDA && q.GetAllowWriteIns() {
+			return nil, pb.NewError(codes.InvalidArgument, pb.ReasonCreateElectionBadQuestion)
+		}
+		// nominees and write-ins have no costs
+		if q.GetType() == pb.BallotType_BALLOT_BUDGET {
+			_, ok := pb.Funding_name[int32(q.GetFunding())]
+			if !ok || q.GetBudget() <= 0 || len(q.Costs) != len(q.Choices) || q.GetAllowWriteIns() || i == 0 && nominating {
+				return nil, pb.NewError(codes.InvalidArgument, pb.ReasonCreateElectionBadBudget)
+			}
+			for _, c := range q.Costs {
+				if c <= 0 {
+					return nil, pb.NewError(codes.InvalidArgument, pb.ReasonCreateElectionBadBudget)
+				}
+			}
+		}
+		questions = append(questions, store.Question{
+			Title: q.GetTitle(),
+			// same numbering
+			Type: store.BallotType(q.GetType()),
+			Choices: q.Choices,
+			Threshold: store.Threshold(q.GetThreshold()),
+			AllowWriteIns: q.GetAllowWriteIns(),
+			Points: int(q.GetPoints()),
+			Costs: q.Costs,
+			Budget: q.GetBudget(),
+			Funding: store.Funding(q.GetFunding()),
+		})
+	}
+	var runoff time.Duration
+	if e.Runoff != nil {
+		runoff = e.Runoff.AsDuration()
+		if runoff < time.Second || len(questions) != 1 || questions[0].Type != store.BallotSingle || questions[0].AllowWriteIns {
+			return nil, pb.NewError(codes.InvalidArgument, pb.ReasonCreateElectionBadRunoff)
+		}
+	}
+	weights := []store.GroupWeight{}
+	for _, w := range e.GroupWeights {
+		if !validGroup(*w.Group) || *w.Weight <= 0 {
+			return nil, pb.NewError(codes.InvalidArgument, pb.ReasonCreateElectionBadWeight)
+		}
+		weights = append(weights, store.GroupWeight{Group: *w.Group, Weight: *w.Weight})
+	}
+	trustees := []store.Trustee{}
+	for _, t := range e.Trustees {
+		_, err = s.db.GetVoter(t)
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, pb.NewError(codes.InvalidArgument, pb.ReasonCreateElectionBadTrustees)
+		}
+		if err != nil {
+			return nil, internalError(err)
+		}
+		trustees = append(trustees, store.Trustee{Name: t})
+	}
+	officers := []store.Officer{}
+	for _, o := range e.Officers {
+		_, err = s.db.GetVoter(o)
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, pb.NewError(codes.InvalidArgument, pb.ReasonCreateElectionBadOfficers)
+		}
+		if err != nil {
+			return nil, internalError(err)
+		}
+		officers = append(officers, store.Officer{Name: o})
+	}
+	seed, commitment, err := newSeed()
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	err = s.db.CreateElection(store.Election{
+		Name: *e.Name,
+		Groups: e.Groups,
+		Questions: questions,
+		StartDate: now,
+		EndDate: e.EndDate.AsTime(),
+		Creator: user,
+		Roll: roll,
+		GroupWeights: weights,
+		AllowRevote: e.GetAllowRevote(),
+		Quorum: int(e.GetQuorum()),
+		Runoff: runoff,
+		NominationsEnd: nominationsEnd,
+		SecondsRequired: int(e.GetSecondsRequired()),
+		AcceptanceRequired: e.GetAcceptanceRequired(),
+		Seed: seed,
+		Commitment: commitment,
+		Trustees: trustees,
+		Officers: officers,
+	})
+	if errors.Is(err, store.ErrExists) {
+		return nil, pb.NewError(codes.AlreadyExists, pb.ReasonCreateElectionExists)
+	}
+	if err != nil {
+		return nil, internalError(err)
+	}
+	syncToBackups()
+
+	status := pb.CreateElectionSuccess
+	return &pb.Status{Code: &status}, nil
+}
+
+func (s eVotingServer) CastVote(_ context.Context, v *pb.Vote) (*pb.Status, error) {
+	user, err := s.verifyToken(v.Token)
+	if err != nil {
+		return nil, pb.NewError(codes.Unauthenticated, pb.ReasonCastVoteUnauthn)
+	}
+
+	answers := []store.Answer{}
+	if v.ChoiceName != nil || v.GetAbstain() {
+		answers = append(answers, store.Answer{Choices: []string{v.GetChoiceName()}, Abstain: v.GetAbstain()})
+	}
+	for _, a := range v.Answers {
+		points := []int{}
+		for _, p := range a.Points {
+			points = append(points, int(p))
+		}
+		answers = append(answers, store.Answer{Question: int(*a.Question), Choices: a.Choices, Points: points, Abstain: a.GetAbstain()})
+	}
+	replaced, err := s.db.CastBallot(store.Ballot{
+		Election: *v.ElectionName,
+		User: user,
+		Answers: answers,
+		At: time.Now(),
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, store.ErrNotFound):
+		return nil, pb.NewError(codes.NotFound, pb.ReasonCastVoteNotFound)
+	case errors.Is(err, store.ErrVoterNotFound):
+		return nil, pb.NewError(codes.Unauthenticated, pb.ReasonCastVoteUnauthn)
+	case errors.Is(err, store.ErrNotEligible):
+		log.Printf("no rule for %s, %s", *v.ElectionName, user)
+		return nil, pb.NewError(codes.PermissionDenied, pb.ReasonCastVoteUnauthz)
+	case errors.Is(err, store.ErrExcluded):
+		log.Printf("%s excluded from %s", user, *v.ElectionName)
+		return nil, pb.NewError(codes.PermissionDenied, pb.ReasonCastVoteExcluded)
+	case errors.Is(err, store.ErrNotInElectorate):
+		return nil, pb.NewError(codes.PermissionDenied, pb.ReasonCastVoteNotInElectorate)
+	case errors.Is(err, store.ErrAlreadyVoted):
+		return nil, pb.NewError(codes.AlreadyExists, pb.ReasonCastVoteAlready)
+	case errors.Is(err, store.ErrElectionEnded):
+		return nil, pb.NewError(codes.FailedPrecondition, pb.ReasonCastVoteEnded)
+	case errors.Is(err, store.ErrNotOpen):
+		return nil, pb.NewError(codes.FailedPrecondition, pb.ReasonCastVoteNotOpen)
+	case errors.Is(err, store.ErrCancelled):
+		return nil, pb.NewError(codes.FailedPrecondition, pb.ReasonCastVoteCancelled)
+	case errors.Is(err, store.ErrNoSuchChoice):
+		return nil, pb.NewError(codes.NotFound, pb.ReasonCastVoteNoSuchChoice)
+	case errors.Is(err, store.ErrNoSuchQuestion):
+		return nil, pb.NewError(codes.NotFound, pb.ReasonCastVoteNoSuchQuestion)
+	case errors.Is(err, store.ErrBadAnswer):
+		return nil, pb.NewError(codes.InvalidArgument, pb.ReasonCastVoteBadAnswer)
+	default:
+		return nil, storeError(err)
+	}
+	syncToBackups()
+	status := pb.CastVoteSuccess
+	return &pb.Status{Code: &status, Replaced: &replaced}, nil
+}
+
+func (s eVotingServer) GetResult(_ context.Context, e *pb.ElectionName) (*pb.ElectionResult, error) {
+	election, err := s.db.GetElection(*e.Name)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, pb.NewError(codes.NotFound, pb.ReasonGetResultNotFound)
+	}
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	if election.Cancelled {
+		return nil, pb.NewError(codes.FailedPrecondition, pb.ReasonGetResultCancelled)
+	}
+	if election.EndDate.After(time.Now()) {
+		return nil, pb.NewError(codes.FailedPrecondition, pb.ReasonGetResultNotYet)
+	}
+
+	result, err := s.result(election)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	result.Certificate, err = certificate(election, result)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	m := sodium.Bytes(result.Certificate)
+	result.Signature = m.SignDetached(s.key.SecretKey).Bytes
+	result.ServerKey = s.key.PublicKey.Bytes
+	for _, o := range election.Officers {
+		o := o
+		if o.Signature == nil {
+			continue
+		}
+		key, err := s.db.GetKey(o.Name)
+		if errors.Is(err, store.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, internalError(err)
+		}
+		// of a document from before otherwise
+		if m.SignVerifyDetached(sodium.Signature{Bytes: o.Signature}, sodium.SignPublicKey{Bytes: key}) == nil {
+			result.Countersignatures = append(result.Countersignatures, &pb.Countersignature{Officer: &o.Name, Signature: o.Signature})
+		}
+	}
+	return result, nil
+}
+
+// result counts votes of an ended election, without certificate.
+func (s eVotingServer) result(election store.Election) (*pb.ElectionResult, error) {
+	tallies, proxies, proxyWeight, err := s.db.Tally(election.ID)
+	if err != nil {
+		return nil, err
+	}
+	turnout, turnoutWeight, err := s.db.Turnout(election.ID)
+	if err != nil {
+		return nil, err
+	}
+	electorate, turnout32 := int32(election.Electorate), int32(turnout)
+	quorumMet := quorumMet(election, turnoutWeight + proxyWeight)
+	status := pb.GetResultSuccess
+	result := &pb.ElectionResult{
+		Status: &status,
+		Electorate: &electorate,
+		Turnout: &turnout32,
+		ElectorateWeight: &election.ElectorateWeight,
+		TurnoutWeight: &turnoutWeight,
+		Proxies: &proxies,
+		ProxyWeight: &proxyWeight,
+		QuorumMet: &quorumMet,
+	}
+	b := beacon(election)
+	if b != nil {
+		result.Seed = election.Seed
+		result.Commitment = election.Commitment
+		result.Beacon = b
+		for _, t := range election.Trustees {
+			t := t
+			if t.Contribution != nil {
+				result.Contributions = append(result.Contributions, &pb.TrusteeContribution{Trustee: &t.Name, Value: t.Contribution})
+			}
+		}
+	}
+	for i, t := range tallies {
+		t := t
+		q := election.Questions[i]
+		order := tieBreakOrder(b, i, t)
+		winner, passed, tieBroken := outcome(q, t, election.ElectorateWeight, ranks(order))
+		passed = passed && quorumMet
+		qr := &pb.QuestionResult{
+			Title: &q.Title,
+			Counts: voteCounts(t.Counts, pb.BallotType(q.Type)),
+			WriteIns: voteCounts(t.WriteIns, pb.BallotType(q.Type)),
+			Abstentions: &t.Abstentions.Votes,
+			AbstentionWeight: &t.Abstentions.Weighted,
+			Passed: &passed,
+		}
+		if winner != "" {
+			qr.Winner = &winner
+		}
+		if tieBroken {
+			qr.TieBroken = &tieBroken
+		}
+		qr.TieBreakOrder = order
+		if q.Type == store.BallotBudget && quorumMet {
+			qr.Funded = fund(q, t, ranks(order))
+		}
+		result.Questions = append(result.Questions, qr)
+	}
+	if len(result.Questions) > 0 {
+		first := result.Questions[0]
+		result.Counts = first.Counts
+		result.WriteIns = first.WriteIns
+		result.Abstentions = first.Abstentions
+		result.AbstentionWeight = first.AbstentionWeight
+		result.Winner = first.Winner
+		result.Passed = first.Passed
+		result.Funded = first.Funded
+	}
+	if election.RunoffElection != "" {
+		result.Runoff = &election.RunoffElection
+	}
+	return result, nil
+}
+
+// resultDocument is the canonical result document of an election, as JSON.
+type resultDocument struct {
+	Election	string	`json:"election"`
+	// SHA-256 of its definition
+	Definition	[]byte	`json:"definition"`
+	ClosedAt	time.Time	`json:"closed_at"`
+	Electorate	int32	`json:"electorate"`
+	ElectorateWeight	int64	`json:"electorate_weight"`
+	Turnout	int32	`json:"turnout"`
+	TurnoutWeight	int64	`json:"turnout_weight"`
+	Proxies	int32	`json:"proxies"`
+	ProxyWeight	int64	`json:"proxy_weight"`
+	Quorum	int	`json:"quorum"`
+	QuorumMet	bool	`json:"quorum_met"`
+	Questions	[]certifiedQuestion	`json:"questions"`
+	Beacon	[]byte	`json:"beacon,omitempty"`
+}
+
+type certifiedQuestion struct {
+	Title	string	`json:"title"`
+	// rules applied
+	Type	string	`json:"type"`
+	Threshold	string	`json:"threshold"`
+	Funding	string	`json:"funding,omitempty"`
+	Counts	[]certifiedCount	`json:"counts"`
+	WriteIns	[]certifiedCount	`json:"write_ins,omitempty"`
+	Abstentions	int32	`json:"abstentions"`
+	AbstentionWeight	int64	`json:"abstention_weight"`
+	Winner	string	`json:"winner,omitempty"`
+	Passed	bool	`json:"passed"`
+	TieBroken	bool	`json:"tie_broken,omitempty"`
+	Funded	[]string	`json:"funded,omitempty"`
+}
+
+type certifiedCount struct {
+	Choice	string	`json:"choice"`
+	Votes	int32	`json:"votes"`
+	Weighted	int64	`json:"weighted"`
+	Points	*int64	`json:"points,omitempty"`
+	Credits	*int64	`json:"credits,omitempty"`
+	Merged	[]string	`json:"merged,omitempty"`
+}
+
+// electionDefinition is what the definition hash of a result document
+// covers, the rules of an election as they stand when voting ends, without
+// what voting changes or keeps secret until then: the electorate snapshot,
+// the seed and contributions of the beacon, countersignatures, and the
+// runoff created. Keys are part of the document format, see ElectionResult in
+// voting.proto, and are kept as is.
+type electionDefinition struct {
+	Name	string	`json:"name"`
+	Creator	string	`json:"creator"`
+	StartDate	time.Time	`json:"start_date"`
+	EndDate	time.Time	`json:"end_date"`
+	Groups	[]string	`json:"groups"`
+	Roll	[]definedRollEntry	`json:"roll"`
+	GroupWeights	[]definedGroupWeight	`json:"group_weights"`
+	AllowRevote	bool	`json:"allow_revote"`
+	Quorum	int	`json:"quorum"`
+	RunoffSeconds	int64	`json:"runoff_seconds"`
+	FirstRound	string	`json:"first_round"`
+	NominationsEnd	*time.Time	`json:"nominations_end"`
+	SecondsRequired	int	`json:"seconds_required"`
+	AcceptanceRequired	bool	`json:"acceptance_required"`
+	Questions	[]definedQuestion	`json:"questions"`
+	Commitment	[]byte	`json:"commitment"`
+	// names only
+	Trustees	[]string	`json:"trustees"`
+	Officers	[]string	`json:"officers"`
+}
+
+type definedRollEntry struct {
+	Name	string	`json:"name"`
+	Exclude	bool	`json:"exclude"`
+}
+
+type definedGroupWeight struct {
+	Group	string	`json:"group"`
+	Weight	int64	`json:"weight"`
+}
+
+type definedQuestion struct {
+	Title	string	`json:"title"`
+	Type	string	`json:"type"`
+	Threshold	string	`json:"threshold"`
+	// with qualified nominees once nominations are settled
+	Choices	[]string	`json:"choices"`
+	AllowWriteIns	bool	`json:"allow_write_ins"`
+	Points	int	`json:"points"`
+	Costs	[]int64	`json:"costs"`
+	Budget	int64	`json:"budget"`
+	Funding	string	`json:"funding"`
+}
+
+// definition returns the definition of e as hashed in its result document.
+func definition(e store.Election) electionDefinition {
+	d := electionDefinition{
+		Name: e.Name,
+		Creator: e.Creator,
+		StartDate: e.StartDate.UTC(),
+		EndDate: e.EndDate.UTC(),
+		Groups: append([]string{}, e.Groups...),
+		Roll: []definedRollEntry{},
+		GroupWeights: []definedGroupWeight{},
+		AllowRevote: e.AllowRevote,
+		Quorum: e.Quorum,
+		RunoffSeconds: int64(e.Runoff / time.Second),
+		FirstRound: e.FirstRound,
+		SecondsRequired: e.SecondsRequired,
+		AcceptanceRequired: e.AcceptanceRequired,
+		Questions: []definedQuestion{},
+		Commitment: e.Commitment,
+		Trustees: []string{},
+		Officers: []string{},
+	}
+	if !e.NominationsEnd.IsZero() {
+		t := e.NominationsEnd.UTC()
+		d.NominationsEnd = &t
+	}
+	for _, r := range e.Roll {
+		d.Roll = append(d.Roll, definedRollEntry{Name: r.Name, Exclude: r.Exclude})
+	}
+	for _, w := range e.GroupWeights {
+		d.GroupWeights = append(d.GroupWeights, definedGroupWeight{Group: w.Group, Weight: w.Weight})
+	}
+	for _, q := range e.Questions {
+		d.Questions = append(d.Questions, definedQuestion{
+			Title: q.Title,
+			Type: pb.BallotType(q.Type).String(),
+			Threshold: pb.Threshold(q.Threshold).String(),
+			Choices: append([]string{}, q.Choices...),
+			AllowWriteIns: q.AllowWriteIns,
+			Points: q.Points,
+			Costs: append([]int64{}, q.Costs...),
+			Budget: q.Budget,
+			Funding: pb.Funding(q.Funding).String(),
+		})
+	}
+	for _, t := range e.Trustees {
+		d.Trustees = append(d.Trustees, t.Name)
+	}
+	for _, o := range e.Officers {
+		d.Officers = append(d.Officers, o.Name)
+	}
+	return d
+}
+
+// certificate returns the result document of e with result, as signed.
+func certificate(e store.Election, result *pb.ElectionResult) ([]byte, error) {
+	j, err := json.Marshal(definition(e))
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(j)
+
+	c := resultDocument{
+		Election: e.Name,
+		Definition: sum[:],
+		ClosedAt: e.EndDate.UTC(),
+		Electorate: result.GetElectorate(),
+		ElectorateWeight: result.GetElectorateWeight(),
+		Turnout: result.GetTurnout(),
+		TurnoutWeight: result.GetTurnoutWeight(),
+		Proxies: result.GetProxies(),
+		ProxyWeight: result.GetProxyWeight(),
+		Quorum: e.Quorum,
+		QuorumMet: result.GetQuorumMet(),
+		Questions: []certifiedQuestion{},
+		Beacon: result.Beacon,
+	}
+	counts := func(list []*pb.VoteCount) []certifiedCount {
+		res := []certifiedCount{}
+		for _, v := range list {
+			res = append(res, certifiedCount{
+				Choice: v.GetChoiceName(),
+				Votes: v.GetCount(),
+				Weighted: v.GetWeightedCount(),
+				Points: v.Points,
+				Credits: v.Credits,
+				Merged: v.Merged,
+			})
+		}
+		return res
+	}
+	for i, qr := range result.Questions {
+		q := e.Questions[i]
+		cq := certifiedQuestion{
+			Title: q.Title,
+			Type: pb.BallotType(q.Type).String(),
+			Threshold: pb.Threshold(q.Threshold).String(),
+			Counts: counts(qr.Counts),
+			WriteIns: counts(qr.WriteIns),
+			Abstentions: qr.GetAbstentions(),
+			AbstentionWeight: qr.GetAbstentionWeight(),
+			Winner: qr.GetWinner(),
+			Passed: qr.GetPassed(),
+			TieBroken: qr.GetTieBroken(),
+			Funded: qr.Funded,
+		}
+		if q.Type == store.BallotBudget {
+			cq.Funding = pb.Funding(q.Funding).String()
+		}
+		c.Questions = append(c.Questions, cq)
+	}
+	return json.Marshal(c)
+}
+
+// voteCounts converts counts of a question of ballot type t.
+func voteCounts(counts []store.Count, t pb.BallotType) []*pb.VoteCount {
+	var res []*pb.VoteCount
+	for _, c := range counts {
+		c := c
+		count := &pb.VoteCount{
+			ChoiceName: &c.Choice,
+			Count: &c.Votes,
+			WeightedCount: &c.Weighted,
+			ProxyCount: &c.Proxies,
+			ProxyWeightedCount: &c.ProxyWeight,
+			Merged: c.Merged,
+		}
+		if pointBased(t) {
+			count.Points = &c.Points
+		}
+		if t == pb.BallotType_BALLOT_QUADRATIC {
+			count.Credits = &c.Credits
+		}
+		res = append(res, count)
+	}
+	return res
+}
+
+// pointBased tells if choices are given points by ballots of type t, and
+// lead by them instead of weighted votes.
+func pointBased(t pb.BallotType) bool {
+	return t == pb.BallotType_BALLOT_CUMULATIVE || t == pb.BallotType_BALLOT_BORDA || t == pb.BallotType_BALLOT_QUADRATIC
+}
+
+// outcome tells the leading choice in t of question q, write-ins included,
+// and if it reaches the threshold of q, regardless of quorum. Ties go to the
+// first by rank, or "" if rank is nil.
+func outcome(q store.Question, t store.Tally, electorateWeight int64, rank map[string]int) (winner string, passed, tieBroken bool) {
+	var lead int64
+	tie := false
+	points := pointBased(pb.BallotType(q.Type))
+	for _, counts := range [][]store.Count{t.Counts, t.WriteIns} {
+		for _, c := range counts {
+			v := c.Weighted
+			if points {
+				v = c.Points
+			}
+			switch {
+			case v > lead:
+				winner, lead, tie = c.Choice, v, false
+			case v == lead:
+				tie = true
+				if rank != nil && winner != "" && rank[c.Choice] < rank[winner] {
+					winner = c.Choice
+				}
+			}
+		}
+	}
+	if winner == "" || tie && rank == nil {
+		return "", false, false
+	}
+	switch q.Threshold {
+	case store.ThresholdPlurality:
+		passed = true
+	case store.ThresholdMajority:
+		passed = lead * 2 > t.VoterWeight
+	case store.ThresholdTwoThirds:
+		passed = lead * 3 >= t.VoterWeight * 2
+	case store.ThresholdAbsoluteMajority:
+		passed = lead * 2 > electorateWeight
+	}
+	return winner, passed, tie
+}
+
+// newSeed returns a random seed of a beacon, and its SHA-256 committing to
+// it.
+func newSeed() (seed, commitment []byte, err error) {
+	seed = make([]byte, seedBytes)
+	_, err = rand.Read(seed)
+	if err != nil {
+		return nil, nil, err
+	}
+	sum := sha256.Sum256(seed)
+	return seed, sum[:], nil
+}
+
+// beacon is the random beacon of e breaking ties, SHA-256 of its seed and
+// contributions of trustees in order of name, each after its length as 4
+// bytes big-endian, nil for elections from before.
+func beacon(e store.Election) []byte {
+	if e.Seed == nil {
+		return nil
+	}
+	h := sha256.New()
+	h.Write(e.Seed)
+	for _, t := range e.Trustees {
+		if t.Contribution == nil {
+			continue
+		}
+		binary.Write(h, binary.BigEndian, uint32(len(t.Contribution)))
+		h.Write(t.Contribution)
+	}
+	return h.Sum(nil)
+}
+
+// tieBreakOrder orders choices and write-ins in t of the question at index
+// question by SHA-256 of b, the index as 4 bytes big-endian and the choice.
+// It is nil if b is.
+func tieBreakOrder(b []byte, question int, t store.Tally) []string {
+	if b == nil {
+		return nil
+	}
+	keys := map[string]string{}
+	order := []string{}
+	for _, counts := range [][]store.Count{t.Counts, t.WriteIns} {
+		for _, c := range counts {
+			h := sha256.New()
+			h.Write(b)
+			binary.Write(h, binary.BigEndian, uint32(question))
+			h.Write([]byte(c.Choice))
+			keys[c.Choice] = string(h.Sum(nil))
+			order = append(order, c.Choice)
+		}
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return keys[order[i]] < keys[order[j]]
+	})
+	return order
+}
+
+// ranks maps choices to their indices in order, nil if order is.
+func ranks(order []string) map[string]int {
+	if order == nil {
+		return nil
+	}
+	rank := map[string]int{}
+	for i, c := range order {
+		rank[c] = i
+	}
+	return rank
+}
+
+// fund selects choices of a BallotBudget question q to fund by t, in order
+// of selection, breaking ties by rank if not nil. Choices no one approves are
+// never funded.
+func fund(q store.Question, t store.Tally, rank map[string]int) []string {
+	// by weighted approvals, then by rank or as listed
+	order := make([]int, len(t.Counts))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := t.Counts[order[i]], t.Counts[order[j]]
+		if a.Weighted != b.Weighted || rank == nil {
+			return a.Weighted > b.Weighted
+		}
+		return rank[a.Choice] < rank[b.Choice]
+	})
+
+	selected := []int{}
+	if q.Funding == store.FundingEqualShares {
+		selected = equalShares(q, t, order)
+	}
+	var spent int64
+	funded := map[int]bool{}
+	for _, c := range selected {
+		spent += q.Costs[c]
+		funded[c] = true
+	}
+	for _, c := range order {
+		if !funded[c] && t.Counts[c].Weighted > 0 && spent + q.Costs[c] <= q.Budget {
+			selected = append(selected, c)
+			spent += q.Costs[c]
+		}
+	}
+
+	names := []string{}
+	for _, c := range selected {
+		names = append(names, q.Choices[c])
+	}
+	return names
+}
+
+// equalShares selects choices of q by the Method of Equal Shares: the budget
+// is split among ballots in t by weight, and the choice whose supporters can
+// pay for it together with the least each by weight is funded next, until
+// none can be. Ties go to the earlier one in order. Shares are exact
+// fractions, so that results don't depend on rounding.
+func equalShares(q store.Question, t store.Tally, order []int) []int {
+	var total int64
+	for _, a := range t.Approvals {
+		total += a.Weight
+	}
+	if total == 0 {
+		return nil
+	}
+	left := make([]*big.Rat, len(t.Approvals))
+	supporters := make([][]int, len(q.Choices))
+	for i, a := range t.Approvals {
+		share := new(big.Int).Mul(big.NewInt(q.Budget), big.NewInt(a.Weight))
+		left[i] = new(big.Rat).SetFrac(share, big.NewInt(total))
+		for _, c := range a.Choices {
+			supporters[c] = append(supporters[c], i)
+		}
+	}
+
+	selected := []int{}
+	funded := make([]bool, len(q.Choices))
+	for {
+		best, bestPrice := -1, (*big.Rat)(nil)
+		for _, c := range order {
+			if funded[c] {
+				continue
+			}
+			p, ok := price(q.Costs[c], supporters[c], t.Approvals, left)
+			if ok && (bestPrice == nil || p.Cmp(bestPrice) < 0) {
+				best, bestPrice = c, p
+			}
+		}
+		if best < 0 {
+			return selected
+		}
+		funded[best] = true
+		selected = append(selected, best)
+		for _, i := range supporters[best] {
+			pay := new(big.Rat).Mul(bestPrice, new(big.Rat).SetInt64(t.Approvals[i].Weight))
+			if pay.Cmp(left[i]) > 0 {
+				pay = left[i]
+			}
+			left[i] = new(big.Rat).Sub(left[i], pay)
+		}
+	}
+}
+
+// price tells the least payment by weight at which supporters, ballots in
+// approvals having left to pay, can pay cost together, if they can.
+func price(cost int64, supporters []int, approvals []store.Approval, left []*big.Rat) (*big.Rat, bool) {
+	perWeight := make(map[int]*big.Rat, len(supporters))
+	var weight int64
+	for _, i := range supporters {
+		perWeight[i] = new(big.Rat).Quo(left[i], new(big.Rat).SetInt64(approvals[i].Weight))
+		weight += approvals[i].Weight
+	}
+	// those with the least left by weight pay all they have
+	sorted := append([]int{}, supporters...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return perWeight[sorted[i]].Cmp(perWeight[sorted[j]]) < 0
+	})
+	remaining := new(big.Rat).SetInt64(cost)
+	for _, i := range sorted {
+		// the rest pay the same by weight if this one can
+		p := new(big.Rat).Quo(remaining, new(big.Rat).SetInt64(weight))
+		if perWeight[i].Cmp(p) >= 0 {
+			return p, true
+		}
+		remaining.Sub(remaining, left[i])
+		weight -= approvals[i].Weight
+	}
+	return nil, false
+}
+
+func electionInfo(e store.Election, now time.Time) *pb.ElectionInfo {
+	state := pb.ElectionState_ELECTION_OPEN
+	if e.Cancelled {
+		state = pb.ElectionState_ELECTION_CANCELLED
+	} else if !now.Before(e.EndDate) {
+		state = pb.ElectionState_ELECTION_CLOSED
+	} else if now.Before(e.NominationsEnd) {
+		state = pb.ElectionState_ELECTION_NOMINATING
+	}
+	info := &pb.ElectionInfo{
+		Name: &e.Name,
+		Groups: e.Groups,
+		EndDate: timestamppb.New(e.EndDate),
+		State: &state,
+	}
+	if !e.StartDate.IsZero() {
+		info.StartDate = timestamppb.New(e.StartDate)
+	}
+	if e.Creator != "" {
+		info.Creator = &e.Creator
+	}
+	if !e.SnapshotDate.IsZero() {
+		electorate := int32(e.Electorate)
+		info.Electorate = &electorate
+		info.ElectorateWeight = &e.ElectorateWeight
+		info.SnapshotDate = timestamppb.New(e.SnapshotDate)
+	}
+	if e.AllowRevote {
+		info.AllowRevote = &e.AllowRevote
+	}
+	if e.Quorum != 0 {
+		quorum := int32(e.Quorum)
+		info.Quorum = &quorum
+	}
+	for i, q := range e.Questions {
+		q := q
+		if i == 0 {
+			info.Choices = q.Choices
+			if q.Threshold != store.ThresholdPlurality {
+				info.Threshold = pb.Threshold(q.Threshold).Enum()
+			}
+			if q.AllowWriteIns {
+				info.AllowWriteIns = &q.AllowWriteIns
+			}
+		}
+		question := &pb.Question{Title: &q.Title, Choices: q.Choices}
+		if q.AllowWriteIns {
+			question.AllowWriteIns = &q.AllowWriteIns
+		}
+		if q.Type != store.BallotSingle {
+			question.Type = pb.BallotType(q.Type).Enum()
+		}
+		if q.Points != 0 {
+			points := int32(q.Points)
+			question.Points = &points
+		}
+		if q.Type == store.BallotBudget {
+			question.Costs = q.Costs
+			question.Budget = &q.Budget
+			question.Funding = pb.Funding(q.Funding).Enum()
+		}
+		if q.Threshold != store.ThresholdPlurality {
+			question.Threshold = pb.Threshold(q.Threshold).Enum()
+		}
+		info.Questions = append(info.Questions, question)
+	}
+	for _, w := range e.GroupWeights {
+		w := w
+		info.GroupWeights = append(info.GroupWeights, &pb.GroupWeight{Group: &w.Group, Weight: &w.Weight})
+	}
+	if e.Runoff != 0 {
+		info.Runoff = durationpb.New(e.Runoff)
+	}
+	if e.RunoffElection != "" {
+		info.RunoffElection = &e.RunoffElection
+	}
+	if e.FirstRound != "" {
+		info.FirstRound = &e.FirstRound
+	}
+	if !e.NominationsEnd.IsZero() {
+		seconds := int32(e.SecondsRequired)
+		info.NominationsEnd = timestamppb.New(e.NominationsEnd)
+		info.SecondsRequired = &seconds
+		info.AcceptanceRequired = &e.AcceptanceRequired
+	}
+	info.Commitment = e.Commitment
+	for _, t := range e.Trustees {
+		info.Trustees = append(info.Trustees, t.Name)
+	}
+	for _, o := range e.Officers {
+		info.Officers = append(info.Officers, o.Name)
+	}
+	return info
+}
+
+// settleElections adds qualified nominees as choices of elections ending
+// nominations, and creates runoffs of elections ending without a majority,
+// as long as this is the primary.
+func settleElections(db store.Store) {
+	for {
+		time.Sleep(time.Second)
+		if primary != *syncAddr {
+			continue
+		}
+		now := time.Now()
+		nominating, err := db.PendingNominations(now)
+		if err != nil {
+			log.Printf("cannot list pending nominations: %v", err)
+			continue
+		}
+		for _, e := range nominating {
+			err = db.SettleNominations(e.ID)
+			if err != nil && !errors.Is(err, store.ErrNotFound) {
+				log.Printf("cannot settle nominations of %s: %v", e.Name, err)
+			}
+		}
+
+		elections, err := db.PendingRunoffs(now)
+		if err != nil {
+			log.Printf("cannot list pending runoffs: %v", err)
+			continue
+		}
+		for _, e := range elections {
+			err = settleRunoff(db, e)
+			if err != nil && !errors.Is(err, store.ErrNotFound) {
+				log.Printf("cannot settle runoff of %s: %v", e.Name, err)
+			}
+		}
+
+		recorded, err := recordEvents(db, now)
+		if err != nil {
+			log.Printf("cannot record events: %v", err)
+		}
+		if len(nominating) > 0 || len(elections) > 0 || recorded {
+			syncToBackups()
+		}
+	}
+}
+
+// turnoutMilestones are percents of the electorate weight turnout events
+// are recorded at, besides the quorum.
+var turnoutMilestones = []int{25, 50, 75, 100}
+
+// recordEvents records events of elections not closed as of the last time,
+// as they open, reach turnout milestones and close, returning whether any.
+func recordEvents(db store.Store, now time.Time) (bool, error) {
+	elections, err := db.UnclosedElections()
+	if err != nil {
+		return false, err
+	}
+	recorded := false
+	for _, e := range elections {
+		opening := e.StartDate
+		if !e.NominationsEnd.IsZero() {
+			opening = e.NominationsEnd
+		}
+		if now.Before(opening) {
+			continue
+		}
+		if e.Cancelled {
+			// instead of opening, with nothing to follow
+			err = db.AddEvent(store.Event{ElectionID: e.ID, Kind: store.EventCancelled, At: opening})
+			if err != nil {
+				return recorded, err
+			}
+			recorded = true
+			continue
+		}
+		events, err := db.ListEvents(e.ID, 0)
+		if err != nil {
+			return recorded, err
+		}
+		opened := false
+		reached := map[int]bool{}
+		for _, ev := range events {
+			opened = opened || ev.Kind == store.EventOpened
+			if ev.Kind == store.EventTurnout {
+				reached[ev.Percent] = true
+			}
+		}
+
+		add := []store.Event{}
+		if !opened {
+			if opening.IsZero() {
+				// elections from before it was recorded
+				opening = now
+			}
+			add = append(add, store.Event{Kind: store.EventOpened, At: opening})
+		}
+		if now.Before(e.EndDate) && e.ElectorateWeight > 0 {
+			_, weight, err := db.Turnout(e.ID)
+			if err != nil {
+				return recorded, err
+			}
+			milestones := append([]int{e.Quorum}, turnoutMilestones...)
+			sort.Ints(milestones)
+			for _, m := range milestones {
+				if m > 0 && !reached[m] && weight * 100 >= int64(m) * e.ElectorateWeight {
+					reached[m] = true
+					add = append(add, store.Event{Kind: store.EventTurnout, At: now, Percent: m})
+				}
+			}
+		} else if !now.Before(e.EndDate) {
+			add = append(add, store.Event{Kind: store.EventClosed, At: e.EndDate},
+				store.Event{Kind: store.EventResult, At: now})
+		}
+		for _, ev := range add {
+			ev.ElectionID = e.ID
+			err = db.AddEvent(ev)
+			if err != nil {
+				return recorded, err
+			}
+			recorded = true
+		}
+	}
+	return recorded, nil
+}
+
+// quorumMet tells if weight of ballots, cast or by proxy, meets the quorum of
+// e.
+func quorumMet(e store.Election, weight int64) bool {
+	return weight * 100 >= int64(e.Quorum) * e.ElectorateWeight
+}
+
+// settleRunoff creates a runoff between the top two choices of e, by
+// weighted votes, unless one has a majority or the quorum is not met. Ties go
+// to the earlier choices.
+func settleRunoff(db store.Store, e store.Election) error {
+	tallies, _, proxyWeight, err := db.Tally(e.ID)
+	if err != nil {
+		return err
+	}
+	_, turnoutWeight, err := db.Turnout(e.ID)
+	if err != nil {
+		return err
+	}
+	if !quorumMet(e, turnoutWeight + proxyWeight) {
+		return db.SettleRunoff(e.ID, nil)
+	}
+	t := tallies[0]
+	rank := ranks(tieBreakOrder(beacon(e), 0, t))
+	counts := append([]store.Count{}, t.Counts...)
+	sort.SliceStable(counts, func(i, j int) bool {
+		if counts[i].Weighted != counts[j].Weighted || rank == nil {
+			return counts[i].Weighted > counts[j].Weighted
+		}
+		return rank[counts[i].Choice] < rank[counts[j].Choice]
+	})
+	if len(counts) < 2 || t.VoterWeight == 0 || counts[0].Weighted * 2 > t.VoterWeight {
+		return db.SettleRunoff(e.ID, nil)
+	}
+
+	seed, commitment, err := newSeed()
+	if err != nil {
+		return err
+	}
+	trustees := []store.Trustee{}
+	for _, t := range e.Trustees {
+		trustees = append(trustees, store.Trustee{Name: t.Name})
+	}
+	officers := []store.Officer{}
+	for _, o := range e.Officers {
+		officers = append(officers, store.Officer{Name: o.Name})
+	}
+	now := time.Now()
+	runoff := store.Election{
+		Groups: e.Groups,
+		Questions: []store.Question{{
+			Title: e.Questions[0].Title,
+			Choices: []string{counts[0].Choice, counts[1].Choice},
+		}},
+		StartDate: now,
+		EndDate: now.Add(e.Runoff),
+		Creator: e.Creator,
+		Roll: e.Roll,
+		GroupWeights: e.GroupWeights,
+		AllowRevote: e.AllowRevote,
+		Quorum: e.Quorum,
+		Seed: seed,
+		Commitment: commitment,
+		Trustees: trustees,
+		Officers: officers,
+	}
+	for i := 1; ; i++ {
+		runoff.Name = e.Name + "-runoff"
+		if i > 1 {
+			runoff.Name += "-" + strconv.Itoa(i)
+		}
+		err = db.SettleRunoff(e.ID, &runoff)
+		if !errors.Is(err, store.ErrExists) {
+			return err
+		}
+	}
+}
+
+func (s eVotingServer) ListElections(_ context.Context, req *pb.ListElectionsRequest) (*pb.ElectionList, error) {
+	now := time.Now()
+	filter := store.ElectionFilter{Limit: defaultPageSize}
+	if req.PageSize != nil && *req.PageSize > 0 && *req.PageSize <= maxPageSize {
+		filter.Limit = int(*req.PageSize)
+	}
+	if req.GetPageToken() != "" {
+		after, err := strconv.ParseInt(*req.PageToken, 10, 64)
+		if err != nil {
+			return nil, pb.NewError(codes.InvalidArgument, pb.ReasonListElectionsBadPageToken)
+		}
+		filter.After = after
+	}
+	switch req.GetState() {
+	case pb.ElectionState_ELECTION_OPEN:
+		filter.OpenAt = now
+	case pb.ElectionState_ELECTION_CLOSED:
+		filter.ClosedAt = now
+	}
+
+	if req.GetEligible() || req.GetMine() {
+		if req.Token == nil {
+			return nil, pb.NewError(codes.Unauthenticated, pb.ReasonListElectionsUnauthn)
+		}
+		user, err := s.verifyToken(req.Token)
+		if err != nil {
+			return nil, pb.NewError(codes.Unauthenticated, pb.ReasonListElectionsUnauthn)
+		}
+		if req.GetMine() {
+			filter.Creator = user
+		}
+		if req.GetEligible() {
+			filter.Eligible = user
+		}
+	}
+
+	// one more to tell if there is a next page
+	pageSize := filter.Limit
+	filter.Limit++
+	elections, err := s.db.ListElections(filter)
 	if err != nil {
-		status = pb.RegisterVoterExists
-	} else {
-		syncToBackups()
-		os.WriteFile(path.Join(s.keysDir, *v.Name), v.PublicKey, 0600)
-		syncKeyToBackups(*v.Name, v.PublicKey)
+		return nil, internalError(err)
 	}
-	return &pb.Status{Code: &status}, nil
+	res := &pb.ElectionList{}
+	if len(elections) > pageSize {
+		elections = elections[:pageSize]
+		next := strconv.FormatInt(elections[pageSize - 1].ID, 10)
+		res.NextPageToken = &next
+	}
+	for _, e := range elections {
+		res.Elections = append(res.Elections, electionInfo(e, now))
+	}
+	return res, nil
 }
 
-func (s registrationServer) UnregisterVoter(_ context.Context, v *pb.VoterName) (*pb.Status, error) {
-	rows, err := s.db.Query("SELECT [group] FROM 'users' WHERE name = $1", v.Name)
+func (s eVotingServer) GetElection(_ context.Context, e *pb.ElectionName) (*pb.ElectionInfo, error) {
+	election, err := s.db.GetElection(*e.Name)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, pb.NewError(codes.NotFound, pb.ReasonGetElectionNotFound)
+	}
+	if err != nil {
+		return nil, internalError(err)
+	}
+	return electionInfo(election, time.Now()), nil
+}
+
+func rollEntries(entries []*pb.RollEntry) []store.RollEntry {
+	roll := []store.RollEntry{}
+	for _, r := range entries {
+		roll = append(roll, store.RollEntry{Name: *r.Name, Exclude: r.GetExclude()})
+	}
+	return roll
+}
+
+// creatorElection authenticates t as the creator of election name, failing
+// with reasons unauthn, notFound and unauthz respectively.
+func (s eVotingServer) creatorElection(t *pb.AuthToken, name, unauthn, notFound, unauthz string) (store.Election, error) {
+	user, err := s.verifyToken(t)
+	if err != nil {
+		return store.Election{}, pb.NewError(codes.Unauthenticated, unauthn)
+	}
+
+	election, err := s.db.GetElection(name)
+	if errors.Is(err, store.ErrNotFound) {
+		return election, pb.NewError(codes.NotFound, notFound)
+	}
+	if err != nil {
+		return election, internalError(err)
+	}
+	if election.Creator != user {
+		return election, pb.NewError(codes.PermissionDenied, unauthz)
+	}
+	return election, nil
+}
+
+func (s eVotingServer) UpdateRoll(_ context.Context, req *pb.UpdateRollRequest) (*pb.Status, error) {
+	election, err := s.creatorElection(req.Token, *req.ElectionName,
+		pb.ReasonUpdateRollUnauthn, pb.ReasonUpdateRollNotFound, pb.ReasonUpdateRollUnauthz)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	if !rows.Next() {
-		rows.Close()
-		status := pb.UnregisterVoterNotFound
-		return &pb.Status{Code: &status}, nil
+	if election.FirstRound != "" {
+		return nil, pb.NewError(codes.FailedPrecondition, pb.ReasonUpdateRollRunoff)
 	}
-	rows.Close()
 
-	os.Remove(path.Join(s.keysDir, *v.Name))
-	s.db.Exec("DELETE FROM 'users' WHERE name = $1", v.Name)
+	err = s.db.UpdateRoll(election.ID, rollEntries(req.Add), req.Remove)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	now := time.Now()
+	if req.GetSnapshot() && now.Before(election.EndDate) {
+		err = s.db.Snapshot(election.ID, now)
+		if err != nil {
+			return nil, internalError(err)
+		}
+	}
 	syncToBackups()
-	status := pb.UnregisterVoterSuccess
+	status := pb.UpdateRollSuccess
 	return &pb.Status{Code: &status}, nil
 }
 
-type eVotingServer struct {
-	pb.UnimplementedEVotingServer
-	keysDir string
-	db *sql.DB
-	key sodium.SignKP
+func (s eVotingServer) SnapshotElectorate(_ context.Context, req *pb.ElectionRequest) (*pb.Status, error) {
+	election, err := s.creatorElection(req.Token, *req.ElectionName,
+		pb.ReasonSnapshotUnauthn, pb.ReasonSnapshotNotFound, pb.ReasonSnapshotUnauthz)
+	if err != nil {
+		return nil, err
+	}
+	if election.FirstRound != "" {
+		return nil, pb.NewError(codes.FailedPrecondition, pb.ReasonSnapshotRunoff)
+	}
+
+	now := time.Now()
+	if !now.Before(election.EndDate) {
+		return nil, pb.NewError(codes.FailedPrecondition, pb.ReasonSnapshotEnded)
+	}
+	err = s.db.Snapshot(election.ID, now)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	syncToBackups()
+	status := pb.SnapshotSuccess
+	return &pb.Status{Code: &status}, nil
 }
 
-func (s eVotingServer) PreAuth(_ context.Context, name *pb.VoterName) (*pb.Challenge, error) {
-	rows, err := s.db.Query("SELECT 'group' FROM 'users' WHERE name = $1", name.Name)
+func (s eVotingServer) AdjudicateWriteIns(_ context.Context, req *pb.WriteInAdjudication) (*pb.Status, error) {
+	election, err := s.creatorElection(req.Token, *req.ElectionName,
+		pb.ReasonAdjudicateWriteInsUnauthn, pb.ReasonAdjudicateWriteInsNotFound, pb.ReasonAdjudicateWriteInsUnauthz)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	if !rows.Next() {
-		return nil, status.Error(codes.Unauthenticated, "voter not registered") 
+	if election.EndDate.After(time.Now()) {
+		return nil, pb.NewError(codes.FailedPrecondition, pb.ReasonAdjudicateWriteInsNotYet)
 	}
-	rows.Close()
 
-	var c [challengeBytes]byte
-	_, err = rand.Read(c[:])
+	merges := map[string]string{}
+	for _, m := range req.Merges {
+		choice := strings.Join(strings.Fields(*m.Choice), " ")
+		if choice == "" {
+			return nil, pb.NewError(codes.InvalidArgument, pb.ReasonAdjudicateWriteInsBadChoice)
+		}
+		merges[*m.WriteIn] = choice
+	}
+	err = s.db.AdjudicateWriteIns(election.ID, int(req.GetQuestion()), merges)
+	if errors.Is(err, store.ErrNoSuchQuestion) {
+		return nil, pb.NewError(codes.NotFound, pb.ReasonAdjudicateWriteInsNoSuchQuestion)
+	}
 	if err != nil {
-		panic(err)
+		return nil, internalError(err)
 	}
-	var challenge [challengeBytes * 2]byte
-	hex.Encode(challenge[:], c[:])
-	_, err = s.db.Exec("INSERT INTO 'challenges' ('name', 'value') VALUES ($1, $2)", name.Name, string(challenge[:]))
+	err = s.db.AddEvent(store.Event{ElectionID: election.ID, Kind: store.EventResult, At: time.Now()})
 	if err != nil {
-		panic(err)
+		log.Printf("cannot record adjudication of %s: %v", election.Name, err)
 	}
 	syncToBackups()
+	status := pb.AdjudicateWriteInsSuccess
+	return &pb.Status{Code: &status}, nil
+}
 
-	return &pb.Challenge{Value: challenge[:]}, nil
+// nominatingElection authenticates the user and resolves an election taking
+// nominations, failing with reasons unauthn, notFound and closed
+// respectively.
+func (s eVotingServer) nominatingElection(t *pb.AuthToken, name, unauthn, notFound, closed string) (string, store.Election, error) {
+	user, err := s.verifyToken(t)
+	if err != nil {
+		return "", store.Election{}, pb.NewError(codes.Unauthenticated, unauthn)
+	}
+
+	election, err := s.db.GetElection(name)
+	if errors.Is(err, store.ErrNotFound) {
+		return user, election, pb.NewError(codes.NotFound, notFound)
+	}
+	if err != nil {
+		return user, election, internalError(err)
+	}
+	if !time.Now().Before(election.NominationsEnd) {
+		return user, election, pb.NewError(codes.FailedPrecondition, closed)
+	}
+	return user, election, nil
 }
 
-type token struct {
-	Sub	string
-	Exp	time.Time
+func (s eVotingServer) Nominate(_ context.Context, req *pb.NominationRequest) (*pb.Status, error) {
+	user, election, err := s.nominatingElection(req.Token, *req.ElectionName,
+		pb.ReasonNominateUnauthn, pb.ReasonNominateNotFound, pb.ReasonNominateClosed)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.Nominate(election.ID, user, *req.Nominee, time.Now())
+	switch {
+	case err == nil:
+	case errors.Is(err, store.ErrNotEligible), errors.Is(err, store.ErrExcluded), errors.Is(err, store.ErrNotInElectorate):
+		return nil, pb.NewError(codes.PermissionDenied, pb.ReasonNominateUnauthz)
+	case errors.Is(err, store.ErrVoterNotFound):
+		return nil, pb.NewError(codes.NotFound, pb.ReasonNominateNoSuchNominee)
+	case errors.Is(err, store.ErrExists):
+		return nil, pb.NewError(codes.AlreadyExists, pb.ReasonNominateExists)
+	default:
+		return nil, storeError(err)
+	}
+	syncToBackups()
+	status := pb.NominationSuccess
+	return &pb.Status{Code: &status}, nil
 }
 
-func (s eVotingServer) Auth(_ context.Context, req *pb.AuthRequest) (*pb.AuthToken, error) {
-	b, err := os.ReadFile(path.Join(s.keysDir, *req.Name.Name))
+func (s eVotingServer) SecondNomination(_ context.Context, req *pb.NominationRequest) (*pb.Status, error) {
+	user, election, err := s.nominatingElection(req.Token, *req.ElectionName,
+		pb.ReasonSecondNominationUnauthn, pb.ReasonSecondNominationNotFound, pb.ReasonSecondNominationClosed)
 	if err != nil {
-		return nil, status.Error(codes.Unauthenticated, "voter not registered")
+		return nil, err
 	}
-	key := sodium.SignPublicKey{Bytes: sodium.Bytes(b)}
-	rows, err := s.db.Query("SELECT value FROM 'challenges' WHERE name = $1", req.Name.Name)
+
+	err = s.db.Second(election.ID, user, *req.Nominee)
+	switch {
+	case err == nil:
+	case errors.Is(err, store.ErrNotEligible), errors.Is(err, store.ErrExcluded), errors.Is(err, store.ErrNotInElectorate):
+		return nil, pb.NewError(codes.PermissionDenied, pb.ReasonSecondNominationUnauthz)
+	case errors.Is(err, store.ErrNotFound):
+		return nil, pb.NewError(codes.NotFound, pb.ReasonSecondNominationNotFound)
+	case errors.Is(err, store.ErrOwnNomination):
+		return nil, pb.NewError(codes.InvalidArgument, pb.ReasonSecondNominationOwn)
+	case errors.Is(err, store.ErrExists):
+		return nil, pb.NewError(codes.AlreadyExists, pb.ReasonSecondNominationAlready)
+	default:
+		return nil, storeError(err)
+	}
+	syncToBackups()
+	status := pb.NominationSuccess
+	return &pb.Status{Code: &status}, nil
+}
+
+func (s eVotingServer) RespondNomination(_ context.Context, req *pb.NominationResponse) (*pb.Status, error) {
+	user, election, err := s.nominatingElection(req.Token, *req.ElectionName,
+		pb.ReasonRespondNominationUnauthn, pb.ReasonRespondNominationNotFound, pb.ReasonRespondNominationClosed)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
-	for rows.Next() {
-		var c string
-		err = rows.Scan(&c)
-		if err != nil {
-			panic(err)
+	err = s.db.RespondNomination(election.ID, user, *req.Accept)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, pb.NewError(codes.NotFound, pb.ReasonRespondNominationNotFound)
+	}
+	if err != nil {
+		return nil, internalError(err)
+	}
+	syncToBackups()
+	status := pb.NominationSuccess
+	return &pb.Status{Code: &status}, nil
+}
+
+func (s eVotingServer) ListNominations(_ context.Context, req *pb.ElectionName) (*pb.NominationList, error) {
+	election, err := s.db.GetElection(*req.Name)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, pb.NewError(codes.NotFound, pb.ReasonListNominationsNotFound)
+	}
+	if err != nil {
+		return nil, internalError(err)
+	}
+	nominations, err := s.db.ListNominations(election.ID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	res := &pb.NominationList{}
+	for _, n := range nominations {
+		n := n
+		seconds := int32(n.Seconds)
+		info := &pb.NominationInfo{
+			Nominee: &n.Nominee,
+			Nominator: &n.Nominator,
+			Seconds: &seconds,
+			Qualified: &n.Qualified,
 		}
-		m := sodium.Bytes([]byte(c))
-		err = m.SignVerifyDetached(sodium.Signature{Bytes: req.Response.Value}, key)
-		if err == nil {
-			rows.Close()
-			s.db.Exec("DELETE FROM 'challenges' WHERE value = $1", c)
-			syncToBackups()
-			j, _ := json.Marshal(token{Sub: *req.Name.Name, Exp: time.Now().Add(time.Hour)})
-			tok := sodium.Bytes(j)
-			token := tok.Sign(s.key.SecretKey)
-			return &pb.AuthToken{Value: token}, nil
+		if n.Accepted || n.Declined {
+			info.Accepted = &n.Accepted
 		}
+		res.Nominations = append(res.Nominations, info)
 	}
-	rows.Close()
-
-	return nil, status.Error(codes.Unauthenticated, "unknown signature")
+	return res, nil
 }
 
-func (s eVotingServer) verifyToken(t *pb.AuthToken) (string, error) {
-	m := sodium.Bytes(t.Value)
-	b, err := m.SignOpen(s.key.PublicKey)
+func (s eVotingServer) ContributeToBeacon(_ context.Context, req *pb.BeaconContribution) (*pb.Status, error) {
+	user, err := s.verifyToken(req.Token)
 	if err != nil {
-		log.Println("invalid signature")
-		return "", err
+		return nil, pb.NewError(codes.Unauthenticated, pb.ReasonContributeToBeaconUnauthn)
+	}
+	election, err := s.db.GetElection(*req.ElectionName)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, pb.NewError(codes.NotFound, pb.ReasonContributeToBeaconNotFound)
 	}
-	var token token
-	err = json.Unmarshal(b, &token)
 	if err != nil {
-		return "", err
+		return nil, internalError(err)
 	}
-	if time.Now().Before(token.Exp) {
-		return token.Sub, nil
+	// the seed is revealed then
+	if !time.Now().Before(election.EndDate) {
+		return nil, pb.NewError(codes.FailedPrecondition, pb.ReasonContributeToBeaconEnded)
 	}
-	return "", errors.New("token expired")
+	if len(req.Value) == 0 {
+		return nil, pb.NewError(codes.InvalidArgument, pb.ReasonContributeToBeaconEmpty)
+	}
+
+	err = s.db.Contribute(election.ID, user, req.Value)
+	switch {
+	case err == nil:
+	case errors.Is(err, store.ErrNotFound):
+		return nil, pb.NewError(codes.PermissionDenied, pb.ReasonContributeToBeaconUnauthz)
+	case errors.Is(err, store.ErrExists):
+		return nil, pb.NewError(codes.AlreadyExists, pb.ReasonContributeToBeaconAlready)
+	default:
+		return nil, storeError(err)
+	}
+	syncToBackups()
+	status := pb.ContributeToBeaconSuccess
+	return &pb.Status{Code: &status}, nil
 }
 
-func (s eVotingServer) CreateElection(_ context.Context, e *pb.Election) (*pb.Status, error) {
-	_, err := s.verifyToken(e.Token)
+func (s eVotingServer) CertifyResult(_ context.Context, req *pb.ResultCertification) (*pb.Status, error) {
+	user, err := s.verifyToken(req.Token)
+	if err != nil {
+		return nil, pb.NewError(codes.Unauthenticated, pb.ReasonCertifyResultUnauthn)
+	}
+	election, err := s.db.GetElection(*req.ElectionName)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, pb.NewError(codes.NotFound, pb.ReasonCertifyResultNotFound)
+	}
 	if err != nil {
-		status := pb.CreateElectionUnauthn
-		return &pb.Status{Code: &status}, nil
+		return nil, internalError(err)
+	}
+	if election.Cancelled {
+		return nil, pb.NewError(codes.FailedPrecondition, pb.ReasonCertifyResultCancelled)
+	}
+	if election.EndDate.After(time.Now()) {
+		return nil, pb.NewError(codes.FailedPrecondition, pb.ReasonCertifyResultNotYet)
+	}
+	officer := false
+	for _, o := range election.Officers {
+		officer = officer || o.Name == user
+	}
+	if !officer {
+		return nil, pb.NewError(codes.PermissionDenied, pb.ReasonCertifyResultUnauthz)
 	}
 
-	if len(e.Choices) == 0 || len(e.Groups) == 0 {
-		status := pb.CreateElectionNoSpec
-		return &pb.Status{Code: &status}, nil
+	result, err := s.result(election)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	doc, err := certificate(election, result)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	key, err := s.db.GetKey(user)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	m := sodium.Bytes(doc)
+	err = m.SignVerifyDetached(sodium.Signature{Bytes: req.Signature}, sodium.SignPublicKey{Bytes: key})
+	if err != nil {
+		return nil, pb.NewError(codes.InvalidArgument, pb.ReasonCertifyResultBadSignature)
 	}
 
-	dateBytes, err := e.EndDate.AsTime().MarshalText()
+	err = s.db.Countersign(election.ID, user, req.Signature)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, pb.NewError(codes.PermissionDenied, pb.ReasonCertifyResultUnauthz)
+	}
 	if err != nil {
-		panic(err)
+		return nil, internalError(err)
 	}
-	rows, err := s.db.Query("INSERT INTO 'elections' ('name', 'end_date') VALUES ($1, $2); SELECT last_insert_rowid()", e.Name, string(dateBytes))
+	err = s.db.AddEvent(store.Event{ElectionID: election.ID, Kind: store.EventCertified, At: time.Now(), Officer: user})
 	if err != nil {
-		status := pb.CreateElectionUnknown
-		return &pb.Status{Code: &status}, nil
+		log.Printf("cannot record certification of %s: %v", election.Name, err)
 	}
-	rows.Next()
-	var id int64
-	rows.Scan(&id)
-	rows.Close()
+	syncToBackups()
+	status := pb.CertifyResultSuccess
+	return &pb.Status{Code: &status}, nil
+}
 
-	for _, g := range(e.Groups) {
-		_, err = s.db.Exec("INSERT INTO 'election_groups' ('election_id', 'group') VALUES ($1, $2)", id, g)
-		if err != nil {
-			panic(err)
-		}
+func (s eVotingServer) WatchElection(req *pb.WatchRequest, stream pb.EVoting_WatchElectionServer) error {
+	user, err := s.verifyToken(req.Token)
+	if err != nil {
+		return pb.NewError(codes.Unauthenticated, pb.ReasonWatchElectionUnauthn)
+	}
+	election, err := s.db.GetElection(*req.ElectionName)
+	if errors.Is(err, store.ErrNotFound) {
+		return pb.NewError(codes.NotFound, pb.ReasonWatchElectionNotFound)
 	}
+	if err != nil {
+		return internalError(err)
+	}
+	turnout := overseer(election, user)
 
-	for _, c := range(e.Choices) {
-		_, err = s.db.Exec("INSERT INTO 'election_choices' ('election_id', 'choice') VALUES ($1, $2)", id, c)
+	after := req.GetAfter()
+	for {
+		events, err := s.db.ListEvents(election.ID, after)
 		if err != nil {
-			panic(err)
+			return internalError(err)
+		}
+		for _, e := range events {
+			e := e
+			after = e.ID
+			if e.Kind == store.EventTurnout && !turnout {
+				continue
+			}
+			percent := int32(e.Percent)
+			ev := &pb.ElectionEvent{
+				Id: &e.ID,
+				Kind: pb.EventKind(e.Kind).Enum(),
+				At: timestamppb.New(e.At),
+			}
+			if e.Kind == store.EventTurnout {
+				ev.Percent = &percent
+			}
+			if e.Officer != "" {
+				ev.Officer = &e.Officer
+			}
+			err = stream.Send(ev)
+			if err != nil {
+				return err
+			}
+		}
+		select {
+		case <-stream.Context().Done():
+			return nil
+		// as often as events are recorded
+		case <-time.After(time.Second):
 		}
 	}
-	syncToBackups()
+}
 
-	status := pb.CreateElectionSuccess
-	return &pb.Status{Code: &status}, nil
+// overseer tells if user is the creator or a certifying officer of e, who
+// may follow its turnout.
+func overseer(e store.Election, user string) bool {
+	allowed := e.Creator == user
+	for _, o := range e.Officers {
+		allowed = allowed || o.Name == user
+	}
+	return allowed
 }
 
-func (s eVotingServer) CastVote(_ context.Context, v *pb.Vote) (*pb.Status, error) {
-	user, err := s.verifyToken(v.Token)
-	if err != nil {
-		status := pb.CastVoteUnauthn
-		return &pb.Status{Code: &status}, nil
-	}
+// minTurnoutInterval keeps timelines of turnout from telling when single
+// ballots were cast.
+const minTurnoutInterval = time.Minute
 
-	rows, err := s.db.Query("SELECT id, end_date FROM 'elections' WHERE name = $1", *v.ElectionName)
+func (s eVotingServer) GetTurnout(_ context.Context, req *pb.TurnoutRequest) (*pb.TurnoutStats, error) {
+	user, err := s.verifyToken(req.Token)
 	if err != nil {
-		panic(err)
+		return nil, pb.NewError(codes.Unauthenticated, pb.ReasonGetTurnoutUnauthn)
 	}
-	if !rows.Next() {
-		rows.Close()
-		status := pb.CastVoteNotFound
-		return &pb.Status{Code: &status}, nil
+	election, err := s.db.GetElection(*req.ElectionName)
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, pb.NewError(codes.NotFound, pb.ReasonGetTurnoutNotFound)
 	}
-	var (
-		id int64
-		timeStr string
-	)
-	rows.Scan(&id, &timeStr)
-	rows.Close()
-	var endTime time.Time
-	err = endTime.UnmarshalText([]byte(timeStr))
 	if err != nil {
-		panic(err)
+		return nil, internalError(err)
+	}
+	if !overseer(election, user) {
+		return nil, pb.NewError(codes.PermissionDenied, pb.ReasonGetTurnoutUnauthz)
 	}
-	if endTime.Before(time.Now()) {
-		return nil, status.Error(codes.Unavailable, "vote ended")
+	interval := time.Hour
+	if req.Interval != nil {
+		interval = req.Interval.AsDuration()
+		if interval < minTurnoutInterval {
+			return nil, pb.NewError(codes.InvalidArgument, pb.ReasonGetTurnoutBadInterval)
+		}
 	}
 
-	rows, err = s.db.Query("SELECT [group] FROM 'users' WHERE name = $1", user)
+	turnout, turnoutWeight, err := s.db.Turnout(election.ID)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	groups, err := s.db.TurnoutByGroup(election.ID)
 	if err != nil {
-		panic(err)
+		return nil, internalError(err)
 	}
-	if !rows.Next() {
-		rows.Close()
-		status := pb.CastVoteUnauthn
-		return &pb.Status{Code: &status}, nil
+	ballots, err := s.db.FirstBallots(election.ID)
+	if err != nil {
+		return nil, internalError(err)
 	}
-	var group string
-	rows.Scan(&group)
-	rows.Close()
 
-	rows, err = s.db.Query("SELECT [group] FROM 'election_groups' WHERE election_id = $1 AND [group] = $2", id, group)
-	if err != nil {
-		panic(err)
+	electorate, turnout32 := int32(election.Electorate), int32(turnout)
+	res := &pb.TurnoutStats{
+		Electorate: &electorate,
+		ElectorateWeight: &election.ElectorateWeight,
+		Turnout: &turnout32,
+		TurnoutWeight: &turnoutWeight,
+	}
+	for _, g := range groups {
+		g := g
+		electorate, turnout := int32(g.Electorate), int32(g.Turnout)
+		res.Groups = append(res.Groups, &pb.GroupTurnout{
+			Group: &g.Group,
+			Electorate: &electorate,
+			ElectorateWeight: &g.ElectorateWeight,
+			Turnout: &turnout,
+			TurnoutWeight: &g.TurnoutWeight,
+		})
 	}
-	if !rows.Next() {
-		log.Printf("no rule for %d, %s", id, group)
-		rows.Close()
-		status := pb.CastVoteUnauthz
-		return &pb.Status{Code: &status}, nil
+
+	start := election.StartDate
+	if !election.NominationsEnd.IsZero() {
+		start = election.NominationsEnd
 	}
-	rows.Close()
+	res.Timeline = timeline(start, interval, ballots)
+	return res, nil
+}
 
-	var choiceId int64
-	rows, err = s.db.Query("SELECT id FROM 'election_choices' WHERE election_id = $1 AND choice = $2", id, *v.ChoiceName)
-	if err != nil {
-		panic(err)
+// timeline is the turnout by the end of each interval since start where
+// any of ballots was cast, or since the first of them truncated to interval
+// if start is zero.
+func timeline(start time.Time, interval time.Duration, ballots []store.FirstBallot) []*pb.TurnoutStep {
+	if start.IsZero() && len(ballots) != 0 {
+		// elections from before it was recorded
+		start = ballots[0].At.Truncate(interval)
 	}
-	if !rows.Next() {
-		rows.Close()
-		return nil, status.Error(codes.NotFound, "no such choice")
+	var (
+		list []*pb.TurnoutStep
+		step *pb.TurnoutStep
+		n int32
+		weight int64
+	)
+	for _, b := range ballots {
+		until := start
+		if b.At.After(start) {
+			until = start.Add((b.At.Sub(start) / interval + 1) * interval)
+		}
+		n++
+		weight += b.Weight
+		if step == nil || !step.Until.AsTime().Equal(until) {
+			step = &pb.TurnoutStep{Until: timestamppb.New(until)}
+			list = append(list, step)
+		}
+		n, weight := n, weight
+		step.Turnout, step.TurnoutWeight = &n, &weight
 	}
-	rows.Scan(&choiceId)
-	rows.Close()
+	return list
+}
 
-	rows, err = s.db.Query("SELECT id FROM 'election_voted' WHERE election_id = $1 AND user = $2", id, user)
+// delegationScope authenticates the delegator and resolves the scope of a
+// delegation, failing with reasons unauthn, noScope, notFound and ended
+// respectively.
+func (s eVotingServer) delegationScope(scope *pb.DelegationScope, unauthn, noScope, notFound, ended string) (store.Delegation, error) {
+	user, err := s.verifyToken(scope.Token)
 	if err != nil {
-		panic(err)
+		return store.Delegation{}, pb.NewError(codes.Unauthenticated, unauthn)
 	}
-	if rows.Next() {
-		rows.Close()
-		status := pb.CastVoteAlready
-		return &pb.Status{Code: &status}, nil
+	d := store.Delegation{Delegator: user}
+	if (scope.ElectionName == nil) == (scope.Group == nil) {
+		return d, pb.NewError(codes.InvalidArgument, noScope)
+	}
+	if scope.Group != nil {
+		if !validGroup(*scope.Group) {
+			return d, pb.NewError(codes.InvalidArgument, noScope)
+		}
+		d.Group = *scope.Group
+		return d, nil
 	}
-	rows.Close()
 
-	_, err = s.db.Exec(`UPDATE 'election_choices' SET votes = votes + 1 WHERE id = $1;
-INSERT INTO 'election_voted' ('election_id', 'user') VALUES ($2, $3)`, choiceId, id, user)
+	election, err := s.db.GetElection(*scope.ElectionName)
+	if errors.Is(err, store.ErrNotFound) {
+		return d, pb.NewError(codes.NotFound, notFound)
+	}
 	if err != nil {
-		panic(err)
+		return d, internalError(err)
 	}
-	syncToBackups()
-	status := pb.CastVoteSuccess
-	return &pb.Status{Code: &status}, nil
+	if !time.Now().Before(election.EndDate) {
+		return d, pb.NewError(codes.FailedPrecondition, ended)
+	}
+	d.ElectionID = election.ID
+	return d, nil
 }
 
-func (s eVotingServer) GetResult(_ context.Context, e *pb.ElectionName) (*pb.ElectionResult, error) {
-	rows, err := s.db.Query("SELECT id, end_date FROM 'elections' WHERE name = $1", *e.Name)
+func (s eVotingServer) Delegate(_ context.Context, req *pb.DelegateRequest) (*pb.Status, error) {
+	d, err := s.delegationScope(req.Scope, pb.ReasonDelegateUnauthn, pb.ReasonDelegateNoScope,
+		pb.ReasonDelegateNotFound, pb.ReasonDelegateEnded)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	if !rows.Next() {
-		rows.Close()
-		status := pb.GetResultNotFound
-		return &pb.ElectionResult{Status: &status}, nil
+	if *req.Delegate == d.Delegator {
+		return nil, pb.NewError(codes.InvalidArgument, pb.ReasonDelegateSelf)
 	}
+	d.Delegate = *req.Delegate
 
-	var (
-		id int64
-		timeStr string
-	)
-	rows.Scan(&id, &timeStr)
-	rows.Close()
+	err = s.db.Delegate(d, time.Now())
+	switch {
+	case err == nil:
+	case errors.Is(err, store.ErrVoterNotFound):
+		return nil, pb.NewError(codes.NotFound, pb.ReasonDelegateNoSuchDelegate)
+	case errors.Is(err, store.ErrDelegationCycle):
+		return nil, pb.NewError(codes.FailedPrecondition, pb.ReasonDelegateCycle)
+	default:
+		return nil, storeError(err)
+	}
+	syncToBackups()
+	status := pb.DelegateSuccess
+	return &pb.Status{Code: &status}, nil
+}
 
-	var endTime time.Time
-	err = endTime.UnmarshalText([]byte(timeStr))
+func (s eVotingServer) RevokeDelegation(_ context.Context, scope *pb.DelegationScope) (*pb.Status, error) {
+	d, err := s.delegationScope(scope, pb.ReasonRevokeDelegationUnauthn, pb.ReasonRevokeDelegationNoScope,
+		pb.ReasonRevokeDelegationNotFound, pb.ReasonRevokeDelegationEnded)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	if endTime.After(time.Now()) {
-		status := pb.GetResultNotYet
-		return &pb.ElectionResult{Status: &status}, nil
+	err = s.db.RevokeDelegation(d.Delegator, d.ElectionID, d.Group, time.Now())
+	if errors.Is(err, store.ErrNotFound) {
+		return nil, pb.NewError(codes.NotFound, pb.ReasonRevokeDelegationNotFound)
 	}
-
-	var res []*pb.VoteCount
-	rows, err = s.db.Query("SELECT choice, votes FROM 'election_choices' WHERE election_id = $1", id)
 	if err != nil {
-		panic(err)
+		return nil, internalError(err)
 	}
-	for rows.Next() {
-		var (
-			choice string
-			votes int32
-		)
-		rows.Scan(&choice, &votes)
-		res = append(res, &pb.VoteCount{ChoiceName: &choice, Count: &votes})
-	}
-	log.Print(res)
-	rows.Close()
-	status := pb.GetResultSuccess
-	return &pb.ElectionResult{Status: &status, Counts: res}, nil
+	syncToBackups()
+	status := pb.DelegateSuccess
+	return &pb.Status{Code: &status}, nil
 }
 
 type syncServer struct {
 	pb.UnimplementedSyncServer
-	keysDir string
-	dbPath	string
 	serverPub	string
 	serverPriv	string
-	db	*sql.DB
+	db	store.Store
 }
 
-func dumpDB(dbPath string) string {
-	// FIXME: is there a better way?
-	bytes, err := exec.Command("sqlite3", dbPath, ".dump").Output()
-	if err != nil {
-		panic(err)
-	}
-	return dbReset + string(bytes)
-}
-
-func (s syncServer) dumpKeys() []*pb.Key {
+func (s syncServer) dumpKeys() ([]*pb.Key, error) {
 	list := []*pb.Key{}
-	files, err := os.ReadDir(s.keysDir)
+	keys, err := s.db.Keys()
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	for _, f := range files {
-		if !f.Type().IsRegular() {
-			continue
-		}
-		name := f.Name()
-		bytes, err := os.ReadFile(path.Join(s.keysDir, name))
-		if err != nil {
-			continue
-		}
-		list = append(list, &pb.Key{Name: &name, Key: bytes})
+	for name, key := range keys {
+		name := name
+		list = append(list, &pb.Key{Name: &name, Key: key})
 	}
-	return list
+	return list, nil
 }
 
 func notifyNodesChanged() {
@@ -415,26 +2226,48 @@ func notifyNodesChanged() {
 
 func (s syncServer) Join(_ context.Context, newNode *pb.NodeIdentifier) (*pb.Dump, error) {
 	if *syncAddr != primary {
-		return nil, status.Error(codes.Unavailable, "i'm not primary")
+		return nil, pb.NewError(codes.Unavailable, pb.ReasonSyncNotPrimary)
+	}
+	current, err := s.db.SchemaVersion()
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if int(newNode.GetSchemaVersion()) != current {
+		log.Printf("turning away %s of schema version %d, ours %d", *newNode.Address, newNode.GetSchemaVersion(), current)
+		return nil, pb.NewError(codes.FailedPrecondition, pb.ReasonSyncSchemaMismatch)
 	}
 	nodes = append(nodes, *newNode.Address)
 	sort.Strings(nodes)
 	notifyNodesChanged()
-	dump := dumpDB(s.dbPath)
-	log.Print(dump)
+	// the backend replicates itself otherwise, only ship what it doesn't
+	dump := ""
+	keys := []*pb.Key{}
+	if replicated != nil {
+		dump, err = replicated.Dump()
+		if err != nil {
+			return nil, internalError(err)
+		}
+		keys, err = s.dumpKeys()
+		if err != nil {
+			return nil, internalError(err)
+		}
+	}
 	serverPriv, _ := os.ReadFile(s.serverPriv)
 	serverPub, _ := os.ReadFile(s.serverPub)
+	version := int32(current)
 	return &pb.Dump{
 		Content: &dump,
-		Keys: s.dumpKeys(),
+		Keys: keys,
 		ServerPub: serverPub,
 		ServerPriv: serverPriv,
+		SchemaVersion: &version,
 	}, nil
 }
 
 func (syncServer) NodesChanged(_ context.Context, newNodes *pb.NodesList) (*pb.Empty, error) {
 	if *syncAddr == primary {
-		panic("join loops back")
+		// join loops back
+		return nil, pb.NewError(codes.FailedPrecondition, pb.ReasonSyncIsPrimary)
 	}
 	log.Println("nodes list update")
 	primary = *newNodes.Primary.Address
@@ -448,14 +2281,34 @@ func (syncServer) NodesChanged(_ context.Context, newNodes *pb.NodesList) (*pb.E
 
 func (s syncServer) Sql(_ context.Context, req *pb.SqlRequest) (*pb.Empty, error) {
 	if *syncAddr == primary {
-		panic("i'm the one who primaries")
+		// i'm the one who primaries
+		return nil, pb.NewError(codes.FailedPrecondition, pb.ReasonSyncIsPrimary)
+	}
+	if replicated == nil {
+		return nil, pb.NewError(codes.FailedPrecondition, pb.ReasonSyncSelfReplicated)
+	}
+	err := replicated.Restore(*req.Command)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	return &pb.Empty{}, nil
+}
+
+func (s syncServer) NewKeys(_ context.Context, keys *pb.KeyList) (*pb.Empty, error) {
+	for _, key := range keys.Keys {
+		err := s.db.PutKey(*key.Name, key.Key)
+		if err != nil {
+			return nil, internalError(err)
+		}
 	}
-	s.db.Exec(*req.Command);
 	return &pb.Empty{}, nil
 }
 
 func (s syncServer) NewKey(_ context.Context, key *pb.Key) (*pb.Empty, error) {
-	os.WriteFile(path.Join(s.keysDir, *key.Name), key.Key, 0600)
+	err := s.db.PutKey(*key.Name, key.Key)
+	if err != nil {
+		return nil, internalError(err)
+	}
 	return &pb.Empty{}, nil
 }
 
@@ -464,8 +2317,15 @@ func (syncServer) Ping(context.Context, *pb.Empty) (*pb.Empty, error) {
 }
 
 func syncToBackups() {
+	if replicated == nil {
+		return
+	}
 	// FIXME do just the new queries instead
-	dump := dumpDB(dbPath)
+	dump, err := replicated.Dump()
+	if err != nil {
+		log.Printf("cannot dump db: %v", err)
+		return
+	}
 	for _, node := range nodes {
 		conn, err := grpc.Dial(node, grpc.WithTransportCredentials(insecure.NewCredentials()))
 		if err != nil {
@@ -479,6 +2339,9 @@ func syncToBackups() {
 }
 
 func syncKeyToBackups(n string, k []byte) {
+	if replicated == nil {
+		return
+	}
 	for _, node := range nodes {
 		conn, err := grpc.Dial(node, grpc.WithTransportCredentials(insecure.NewCredentials()))
 		if err != nil {
@@ -490,6 +2353,21 @@ func syncKeyToBackups(n string, k []byte) {
 	}
 }
 
+func syncKeysToBackups(keys []*pb.Key) {
+	if replicated == nil {
+		return
+	}
+	for _, node := range nodes {
+		conn, err := grpc.Dial(node, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			continue
+		}
+		defer conn.Close()
+		client := pb.NewSyncClient(conn)
+		client.NewKeys(context.Background(), &pb.KeyList{Keys: keys});
+	}
+}
+
 func waitForPrimeTime() {
 	for {
 		time.Sleep(time.Second)
@@ -529,24 +2407,38 @@ func waitForPrimeTime() {
 	}
 }
 
-func syncFromPrimary(keysDir, pub, priv string, db *sql.DB) {
-	os.RemoveAll(keysDir)
-	os.MkdirAll(keysDir, 0700)
+func syncFromPrimary(pub, priv string, db store.Store) {
 	conn, err := grpc.Dial(*primaryAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		log.Fatalf("cannot dial primary: %v", err)
 	}
 	client := pb.NewSyncClient(conn)
-	state, err := client.Join(context.Background(), &pb.NodeIdentifier{Address: syncAddr})
+	version := int32(store.LatestSchemaVersion())
+	state, err := client.Join(context.Background(), &pb.NodeIdentifier{Address: syncAddr, SchemaVersion: &version})
 	if err != nil {
-		log.Fatalf("cannot join: %v", err)
+		log.Fatalf("cannot join: %v", pb.ToError(err))
 	}
-	_, err = db.Exec(*state.Content)
-	if err != nil {
-		log.Fatalf("cannot sync db: %v", err)
+	if int(state.GetSchemaVersion()) != store.LatestSchemaVersion() {
+		log.Fatalf("cannot join: primary schema version %d, ours %d", state.GetSchemaVersion(), store.LatestSchemaVersion())
 	}
-	for _, key := range state.Keys {
-		os.WriteFile(path.Join(keysDir, *key.Name), key.Key, 0600)
+	if replicated != nil {
+		if *state.Content == "" {
+			log.Fatal("cannot join: primary does not ship its database, use the same backend")
+		}
+		err = replicated.Restore(*state.Content)
+		if err != nil {
+			log.Fatalf("cannot sync db: %v", err)
+		}
+		keys, err := db.Keys()
+		if err != nil {
+			log.Fatalf("cannot list keys: %v", err)
+		}
+		for name := range keys {
+			db.RemoveKey(name)
+		}
+		for _, key := range state.Keys {
+			db.PutKey(*key.Name, key.Key)
+		}
 	}
 	os.WriteFile(pub, state.ServerPub, 0600)
 	os.WriteFile(priv, state.ServerPriv, 0600)
@@ -571,27 +2463,33 @@ func main() {
 	serverPrivPath := path.Join(dataDir, "key")
 	serverPubPath := serverPrivPath + ".pub"
 
-	dbPath = path.Join(dataDir, "db.sqlite")
-	db, err := sql.Open("sqlite", dbPath)
-	defer db.Close()
+	var db store.Store
+	if *postgresDSN != "" {
+		db, err = store.OpenPostgres(*postgresDSN)
+	} else {
+		var sqlite *store.SQLite
+		sqlite, err = store.OpenSQLite(path.Join(dataDir, "db.sqlite"), keysDir)
+		db, replicated = sqlite, sqlite
+	}
 	if err != nil {
 		log.Fatalf("failed to open database: %v", err)
 	}
-	_, err = db.Exec(dbSchema)
+	defer db.Close()
+	err = db.Migrate()
 	if err != nil {
-		log.Printf("cannot init db: %v", err)
+		log.Fatalf("cannot migrate db: %v", err)
 	}
 
 	syncLn, err := net.Listen("tcp", *syncAddr)
 	if err != nil {
 		log.Fatalf("failed to listen %s: %v", *syncAddr, err)
 	}
-	sServer := grpc.NewServer()
-	pb.RegisterSyncServer(sServer, &syncServer{keysDir: keysDir, dbPath: dbPath, db: db, serverPub: serverPubPath, serverPriv: serverPrivPath})
+	sServer := newServer()
+	pb.RegisterSyncServer(sServer, &syncServer{db: db, serverPub: serverPubPath, serverPriv: serverPrivPath})
 	go sServer.Serve(syncLn)
 
 	if *primaryAddr != "" {
-		syncFromPrimary(keysDir, serverPubPath, serverPrivPath, db)
+		syncFromPrimary(serverPubPath, serverPrivPath, db)
 	} else {
 		primary = *syncAddr
 		bytes, _ := exec.Command("/bin/sh", "-c", *primaryAction).CombinedOutput()
@@ -628,15 +2526,16 @@ func main() {
 		log.Fatalf("failed to listen %s: %v", *voteAddr, err)
 	}
 
-	registServer := grpc.NewServer()
-	voteServer := grpc.NewServer()
+	registServer := newServer()
+	voteServer := newServer()
 
-	pb.RegisterRegistrationServer(registServer, &registrationServer{keysDir: keysDir, db: db})
-	pb.RegisterEVotingServer(voteServer, &eVotingServer{keysDir: keysDir, db: db, key: kp})
+	pb.RegisterRegistrationServer(registServer, &registrationServer{db: db})
+	pb.RegisterEVotingServer(voteServer, &eVotingServer{db: db, key: kp})
 
 	if *primaryAddr != "" { // backup
 		go waitForPrimeTime()
 	}
+	go settleElections(db)
 
 	go registServer.Serve(registLn)
 	voteServer.Serve(voteLn)
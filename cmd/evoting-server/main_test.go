@@ -0,0 +1,578 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"path"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jamesruan/sodium"
+	pb "github.com/xdavidwu/evoting/proto"
+	"github.com/xdavidwu/evoting/store"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// counts are of choices named as given with weighted votes as given.
+func counts(choices []string, weighted ...int64) []store.Count {
+	list := []store.Count{}
+	for i, c := range choices {
+		list = append(list, store.Count{Choice: c, Votes: int32(weighted[i]), Weighted: weighted[i]})
+	}
+	return list
+}
+
+// budgetQuestion is of choices a, b and c costing 10, 10 and 6 of a budget
+// of 20.
+func budgetQuestion(funding store.Funding) store.Question {
+	return store.Question{
+		Type: store.BallotBudget,
+		Choices: []string{"a", "b", "c"},
+		Costs: []int64{10, 10, 6},
+		Budget: 20,
+		Funding: funding,
+	}
+}
+
+// budgetTally has two ballots approving a and b, and one approving c.
+func budgetTally() store.Tally {
+	return store.Tally{
+		Counts: counts([]string{"a", "b", "c"}, 2, 2, 1),
+		Approvals: []store.Approval{{Weight: 1, Choices: []int{0, 1}}, {Weight: 1, Choices: []int{0, 1}}, {Weight: 1, Choices: []int{2}}},
+		Voters: 3,
+		VoterWeight: 3,
+	}
+}
+
+func TestOutcome(t *testing.T) {
+	tests := []struct {
+		name	string
+		q	store.Question
+		t	store.Tally
+		electorateWeight	int64
+		rank	map[string]int
+		winner	string
+		passed, tieBroken	bool
+	}{
+		{"plurality", store.Question{}, store.Tally{Counts: counts([]string{"a", "b"}, 3, 2), VoterWeight: 5}, 10, nil, "a", true, false},
+		{"no majority", store.Question{Threshold: store.ThresholdMajority},
+			store.Tally{Counts: counts([]string{"a", "b", "c"}, 3, 2, 2), VoterWeight: 7}, 10, nil, "a", false, false},
+		{"majority", store.Question{Threshold: store.ThresholdMajority},
+			store.Tally{Counts: counts([]string{"a", "b"}, 4, 3), VoterWeight: 7}, 10, nil, "a", true, false},
+		{"two-thirds", store.Question{Threshold: store.ThresholdTwoThirds},
+			store.Tally{Counts: counts([]string{"a", "b"}, 4, 2), VoterWeight: 6}, 10, nil, "a", true, false},
+		{"no absolute majority", store.Question{Threshold: store.ThresholdAbsoluteMajority},
+			store.Tally{Counts: counts([]string{"a", "b"}, 4, 1), VoterWeight: 5}, 10, nil, "a", false, false},
+		{"tie", store.Question{}, store.Tally{Counts: counts([]string{"a", "b"}, 2, 2), VoterWeight: 4}, 10, nil, "", false, false},
+		{"tie broken", store.Question{}, store.Tally{Counts: counts([]string{"a", "b"}, 2, 2), VoterWeight: 4}, 10,
+			map[string]int{"b": 0, "a": 1}, "b", true, true},
+		{"no votes", store.Question{}, store.Tally{Counts: counts([]string{"a", "b"}, 0, 0)}, 10, nil, "", false, false},
+		{"write-in", store.Question{AllowWriteIns: true},
+			store.Tally{Counts: counts([]string{"a"}, 1), WriteIns: counts([]string{"x"}, 2), VoterWeight: 3}, 10, nil, "x", true, false},
+		{"by points", store.Question{Type: store.BallotBorda},
+			store.Tally{Counts: []store.Count{{Choice: "a", Weighted: 3, Points: 3}, {Choice: "b", Weighted: 3, Points: 4}}, VoterWeight: 3},
+			10, nil, "b", true, false},
+	}
+	for _, tt := range tests {
+		winner, passed, tieBroken := outcome(tt.q, tt.t, tt.electorateWeight, tt.rank)
+		if winner != tt.winner || passed != tt.passed || tieBroken != tt.tieBroken {
+			t.Errorf("%s: outcome = %q, %v, %v, want %q, %v, %v", tt.name, winner, passed, tieBroken, tt.winner, tt.passed, tt.tieBroken)
+		}
+	}
+}
+
+func TestPrice(t *testing.T) {
+	rats := func(values ...*big.Rat) []*big.Rat {
+		return values
+	}
+	tests := []struct {
+		name	string
+		cost	int64
+		supporters	[]int
+		approvals	[]store.Approval
+		left	[]*big.Rat
+		price	*big.Rat
+		ok	bool
+	}{
+		{"even", 60, []int{0, 1, 2}, []store.Approval{{Weight: 1}, {Weight: 1}, {Weight: 1}},
+			rats(big.NewRat(25, 1), big.NewRat(25, 1), big.NewRat(25, 1)), big.NewRat(20, 1), true},
+		{"short", 60, []int{0, 1}, []store.Approval{{Weight: 1}, {Weight: 1}},
+			rats(big.NewRat(25, 1), big.NewRat(25, 1)), nil, false},
+		{"poorest pays all", 45, []int{0, 1, 2}, []store.Approval{{Weight: 1}, {Weight: 1}, {Weight: 1}},
+			rats(big.NewRat(5, 1), big.NewRat(30, 1), big.NewRat(30, 1)), big.NewRat(20, 1), true},
+		{"by weight", 30, []int{0, 1}, []store.Approval{{Weight: 2}, {Weight: 1}},
+			rats(big.NewRat(20, 1), big.NewRat(10, 1)), big.NewRat(10, 1), true},
+		{"exact thirds", 10, []int{0, 1, 2}, []store.Approval{{Weight: 1}, {Weight: 1}, {Weight: 1}},
+			rats(big.NewRat(10, 3), big.NewRat(10, 3), big.NewRat(10, 3)), big.NewRat(10, 3), true},
+		{"some supporters", 10, []int{1}, []store.Approval{{Weight: 1}, {Weight: 1}},
+			rats(big.NewRat(10, 1), big.NewRat(5, 1)), nil, false},
+		{"no supporters", 10, nil, nil, nil, nil, false},
+	}
+	for _, tt := range tests {
+		p, ok := price(tt.cost, tt.supporters, tt.approvals, tt.left)
+		if ok != tt.ok || ok && p.Cmp(tt.price) != 0 {
+			t.Errorf("%s: price = %v, %v, want %v, %v", tt.name, p, ok, tt.price, tt.ok)
+		}
+	}
+}
+
+func TestEqualShares(t *testing.T) {
+	tests := []struct {
+		name	string
+		q	store.Question
+		t	store.Tally
+		order	[]int
+		selected	[]int
+	}{
+		{"proportional", budgetQuestion(store.FundingEqualShares), budgetTally(), []int{0, 1, 2}, []int{0, 2}},
+		{"exact thirds", store.Question{Choices: []string{"a"}, Costs: []int64{10}, Budget: 10},
+			store.Tally{Approvals: []store.Approval{{Weight: 1, Choices: []int{0}}, {Weight: 1, Choices: []int{0}}, {Weight: 1, Choices: []int{0}}}},
+			[]int{0}, []int{0}},
+		{"by weight", store.Question{Choices: []string{"a", "b"}, Costs: []int64{6, 6}, Budget: 9},
+			store.Tally{Approvals: []store.Approval{{Weight: 2, Choices: []int{0}}, {Weight: 1, Choices: []int{1}}}},
+			[]int{0, 1}, []int{0}},
+		{"tie in order", store.Question{Choices: []string{"a", "b"}, Costs: []int64{5, 5}, Budget: 5},
+			store.Tally{Approvals: []store.Approval{{Weight: 1, Choices: []int{0, 1}}}},
+			[]int{1, 0}, []int{1}},
+		{"no ballots", budgetQuestion(store.FundingEqualShares), store.Tally{}, []int{0, 1, 2}, nil},
+	}
+	for _, tt := range tests {
+		selected := equalShares(tt.q, tt.t, tt.order)
+		if !reflect.DeepEqual(selected, tt.selected) {
+			t.Errorf("%s: equalShares = %v, want %v", tt.name, selected, tt.selected)
+		}
+	}
+}
+
+func TestFund(t *testing.T) {
+	tests := []struct {
+		name	string
+		q	store.Question
+		t	store.Tally
+		rank	map[string]int
+		funded	[]string
+	}{
+		{"greedy", budgetQuestion(store.FundingGreedy), budgetTally(), nil, []string{"a", "b"}},
+		{"equal shares", budgetQuestion(store.FundingEqualShares), budgetTally(), nil, []string{"a", "c"}},
+		{"as listed", store.Question{Choices: []string{"a", "b"}, Costs: []int64{10, 10}, Budget: 10},
+			store.Tally{Counts: counts([]string{"a", "b"}, 1, 1)}, nil, []string{"a"}},
+		{"by rank", store.Question{Choices: []string{"a", "b"}, Costs: []int64{10, 10}, Budget: 10},
+			store.Tally{Counts: counts([]string{"a", "b"}, 1, 1)}, map[string]int{"b": 0, "a": 1}, []string{"b"}},
+		{"cheaper after", store.Question{Choices: []string{"a", "b", "c"}, Costs: []int64{8, 5, 2}, Budget: 10},
+			store.Tally{Counts: counts([]string{"a", "b", "c"}, 3, 2, 1)}, nil, []string{"a", "c"}},
+		{"unapproved", store.Question{Choices: []string{"a"}, Costs: []int64{1}, Budget: 10},
+			store.Tally{Counts: counts([]string{"a"}, 0)}, nil, []string{}},
+	}
+	for _, tt := range tests {
+		funded := fund(tt.q, tt.t, tt.rank)
+		if !reflect.DeepEqual(funded, tt.funded) {
+			t.Errorf("%s: fund = %v, want %v", tt.name, funded, tt.funded)
+		}
+	}
+}
+
+// testKey is a signing key pair from a seed of b repeated.
+func testKey(b byte) sodium.SignKP {
+	return sodium.SeedSignKP(sodium.SignSeed{Bytes: bytes.Repeat([]byte{b}, sodium.SignSeed{}.Size())})
+}
+
+// openTestStore opens a fresh SQLite store, migrated, in a temporary
+// directory.
+func openTestStore(t *testing.T) store.Store {
+	dir := t.TempDir()
+	db, err := store.OpenSQLite(path.Join(dir, "db.sqlite"), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	err = db.Migrate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// goldenCertificate is the result document of the election in
+// TestCertificate. It is what officers sign, so any change to it breaks
+// countersignatures of existing results.
+const goldenCertificate = `{"election":"e1","definition":"jbc+BfGS0WjH9jquFgygkn9MeIOVl3RQIABWwC/qW04=","closed_at":"2024-01-02T00:00:00Z",` +
+	`"electorate":2,"electorate_weight":3,"turnout":2,"turnout_weight":3,"proxies":0,"proxy_weight":0,"quorum":50,"quorum_met":true,` +
+	`"questions":[{"title":"q","type":"BALLOT_SINGLE","threshold":"THRESHOLD_PLURALITY",` +
+	`"counts":[{"choice":"a","votes":1,"weighted":1},{"choice":"b","votes":1,"weighted":2}],` +
+	`"abstentions":0,"abstention_weight":0,"winner":"b","passed":true}]}`
+
+const goldenCertificateSum = "6ebd4aea4016104bfbcf94d716f8f6317d8e9572f1aa64c17bcf6d78b46b6496"
+
+func TestCertificate(t *testing.T) {
+	db := openTestStore(t)
+	for _, v := range []store.Voter{{Name: "alice", Groups: []string{"g1"}}, {Name: "bob", Groups: []string{"g1"}, Weight: 2}} {
+		err := db.AddVoter(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	officer := testKey(2)
+	err := db.PutKey("bob", officer.PublicKey.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	err = db.CreateElection(store.Election{
+		Name: "e1",
+		Groups: []string{"g1"},
+		Questions: []store.Question{{Title: "q", Choices: []string{"a", "b"}}},
+		StartDate: start,
+		EndDate: start.Add(24 * time.Hour),
+		Creator: "alice",
+		Quorum: 50,
+		Officers: []store.Officer{{Name: "bob"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for user, choice := range map[string]string{"alice": "a", "bob": "b"} {
+		_, err = db.CastBallot(store.Ballot{Election: "e1", User: user, Answers: []store.Answer{{Choices: []string{choice}}}, At: start.Add(time.Hour)})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := eVotingServer{db: db, key: testKey(1)}
+	name := "e1"
+	result, err := s.GetResult(context.Background(), &pb.ElectionName{Name: &name})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(result.Certificate) != goldenCertificate {
+		t.Errorf("certificate = %s, want %s", result.Certificate, goldenCertificate)
+	}
+	sum := sha256.Sum256(result.Certificate)
+	if hex.EncodeToString(sum[:]) != goldenCertificateSum {
+		t.Errorf("certificate SHA-256 = %x, want %s", sum, goldenCertificateSum)
+	}
+	m := sodium.Bytes(result.Certificate)
+	if !bytes.Equal(result.ServerKey, s.key.PublicKey.Bytes) ||
+		m.SignVerifyDetached(sodium.Signature{Bytes: result.Signature}, sodium.SignPublicKey{Bytes: result.ServerKey}) != nil {
+		t.Errorf("certificate not signed by the server key")
+	}
+	if len(result.Countersignatures) != 0 {
+		t.Errorf("countersignatures = %v before countersigning", result.Countersignatures)
+	}
+
+	e, err := db.GetElection("e1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.Countersign(e.ID, "bob", m.SignDetached(officer.SecretKey).Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err = s.GetResult(context.Background(), &pb.ElectionName{Name: &name})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Countersignatures) != 1 || result.Countersignatures[0].GetOfficer() != "bob" ||
+		m.SignVerifyDetached(sodium.Signature{Bytes: result.Countersignatures[0].Signature}, officer.PublicKey) != nil {
+		t.Errorf("countersignatures = %v, want a valid one by bob", result.Countersignatures)
+	}
+
+	// of another document, e.g. from before a change
+	err = db.Countersign(e.ID, "bob", sodium.Bytes("other").SignDetached(officer.SecretKey).Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err = s.GetResult(context.Background(), &pb.ElectionName{Name: &name})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Countersignatures) != 0 {
+		t.Errorf("countersignatures = %v, want none valid", result.Countersignatures)
+	}
+}
+
+func TestBeacon(t *testing.T) {
+	e := store.Election{Seed: bytes.Repeat([]byte{1}, seedBytes), Trustees: []store.Trustee{
+		{Name: "carol", Contribution: []byte("ab")},
+		{Name: "dave"},
+		{Name: "erin", Contribution: []byte("c")},
+	}}
+	const golden = "8e93a2b63b8e04216a8bb90d978a4163a9ae2d9226f74629006d6233467d5ff3"
+	if b := beacon(e); hex.EncodeToString(b) != golden {
+		t.Errorf("beacon = %x, want %s", b, golden)
+	}
+
+	// the same bytes contributed otherwise
+	other := store.Election{Seed: e.Seed, Trustees: []store.Trustee{
+		{Name: "carol", Contribution: []byte("a")},
+		{Name: "erin", Contribution: []byte("bc")},
+	}}
+	if bytes.Equal(beacon(other), beacon(e)) {
+		t.Errorf("beacon of contributions %q and %q is the same", "ab, c", "a, bc")
+	}
+	if b := beacon(store.Election{}); b != nil {
+		t.Errorf("beacon without seed = %x, want nil", b)
+	}
+}
+
+func TestTieBreakOrder(t *testing.T) {
+	b := bytes.Repeat([]byte{1}, sha256.Size)
+	tally := store.Tally{Counts: counts([]string{"a", "b", "c"}, 1, 1, 1), WriteIns: counts([]string{"d"}, 1)}
+	tests := []struct {
+		question	int
+		order	[]string
+	}{
+		{0, []string{"a", "d", "b", "c"}},
+		{2, []string{"d", "c", "a", "b"}},
+	}
+	for _, tt := range tests {
+		order := tieBreakOrder(b, tt.question, tally)
+		if !reflect.DeepEqual(order, tt.order) {
+			t.Errorf("tieBreakOrder of question %d = %q, want %q", tt.question, order, tt.order)
+		}
+	}
+	if order := tieBreakOrder(nil, 0, tally); order != nil {
+		t.Errorf("tieBreakOrder without beacon = %q, want nil", order)
+	}
+}
+
+func TestTimeline(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	// weighing as given, cast after start by as given
+	ballot := func(after time.Duration, weight int64) store.FirstBallot {
+		return store.FirstBallot{At: start.Add(after), Weight: weight}
+	}
+	type step struct {
+		until	time.Duration
+		turnout	int32
+		weight	int64
+	}
+	tests := []struct {
+		name	string
+		start	time.Time
+		ballots	[]store.FirstBallot
+		steps	[]step
+	}{
+		{"none", start, nil, nil},
+		// nothing in the second hour
+		{"cumulative", start, []store.FirstBallot{ballot(0, 1), ballot(10 * time.Minute, 1), ballot(50 * time.Minute, 2), ballot(150 * time.Minute, 1)},
+			[]step{{0, 1, 1}, {time.Hour, 3, 4}, {3 * time.Hour, 4, 5}}},
+		{"without start", time.Time{}, []store.FirstBallot{ballot(320 * time.Minute, 1), ballot(340 * time.Minute, 1), ballot(430 * time.Minute, 2)},
+			[]step{{6 * time.Hour, 2, 2}, {8 * time.Hour, 3, 4}}},
+	}
+	for _, tt := range tests {
+		var steps []step
+		for _, s := range timeline(tt.start, time.Hour, tt.ballots) {
+			steps = append(steps, step{s.Until.AsTime().Sub(start), s.GetTurnout(), s.GetTurnoutWeight()})
+		}
+		if !reflect.DeepEqual(steps, tt.steps) {
+			t.Errorf("%s: timeline = %v, want %v", tt.name, steps, tt.steps)
+		}
+	}
+}
+
+// testToken is a token of user issued by s, as Auth does.
+func testToken(s eVotingServer, user string) *pb.AuthToken {
+	j, _ := json.Marshal(token{Sub: user, Exp: time.Now().Add(time.Hour)})
+	return &pb.AuthToken{Value: sodium.Bytes(j).Sign(s.key.SecretKey)}
+}
+
+// eventStream collects events sent by WatchElection, which returns after
+// those recorded so far as its context is done.
+type eventStream struct {
+	grpc.ServerStream
+	ctx	context.Context
+	events	[]*pb.ElectionEvent
+}
+
+func (s *eventStream) Send(e *pb.ElectionEvent) error {
+	s.events = append(s.events, e)
+	return nil
+}
+
+func (s *eventStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestEvents(t *testing.T) {
+	db := openTestStore(t)
+	for _, v := range []store.Voter{{Name: "alice", Groups: []string{"g1"}}, {Name: "bob", Groups: []string{"g2"}},
+		{Name: "carol", Groups: []string{"g1"}}, {Name: "dave", Groups: []string{"g1"}}} {
+		err := db.AddVoter(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	now := time.Now().Truncate(time.Second)
+	start, end := now.Add(-time.Hour), now.Add(time.Hour)
+	err := db.CreateElection(store.Election{
+		Name: "e1",
+		Groups: []string{"g1"},
+		Questions: []store.Question{{Choices: []string{"a", "b"}}},
+		StartDate: start,
+		EndDate: end,
+		Creator: "alice",
+		Officers: []store.Officer{{Name: "bob"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := db.GetElection("e1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// alice, then carol, out of three
+	steps := []struct {
+		voter	string
+		at	time.Time
+		recorded	bool
+	}{
+		{"", now, true},
+		{"", now, false},
+		{"alice", now, true},
+		{"carol", now, true},
+		{"", end, true},
+		{"", end.Add(time.Minute), false},
+	}
+	for i, step := range steps {
+		if step.voter != "" {
+			_, err = db.CastBallot(store.Ballot{Election: "e1", User: step.voter, Answers: []store.Answer{{Choices: []string{"a"}}}, At: step.at})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		recorded, err := recordEvents(db, step.at)
+		if err != nil || recorded != step.recorded {
+			t.Errorf("recordEvents at step %d = %t, %v, want %t", i, recorded, err, step.recorded)
+		}
+	}
+
+	type event struct {
+		kind	pb.EventKind
+		at	time.Time
+		percent	int32
+	}
+	all := []event{
+		{pb.EventKind_EVENT_OPENED, start, 0},
+		{pb.EventKind_EVENT_TURNOUT, now, 25},
+		{pb.EventKind_EVENT_TURNOUT, now, 50},
+		{pb.EventKind_EVENT_CLOSED, end, 0},
+		{pb.EventKind_EVENT_RESULT, end, 0},
+	}
+	s := eVotingServer{db: db, key: testKey(1)}
+	// a done context, for what is recorded so far
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	watch := func(user string, after int64) ([]*pb.ElectionEvent, error) {
+		name := "e1"
+		req := &pb.WatchRequest{ElectionName: &name, Token: testToken(s, user)}
+		if after != 0 {
+			req.After = &after
+		}
+		stream := &eventStream{ctx: ctx}
+		err := s.WatchElection(req, stream)
+		return stream.events, err
+	}
+	check := func(name string, got []*pb.ElectionEvent, want []event) {
+		list := []event{}
+		for _, ev := range got {
+			list = append(list, event{ev.GetKind(), ev.At.AsTime(), ev.GetPercent()})
+		}
+		if len(list) != len(want) {
+			t.Errorf("%s: events = %v, want %v", name, list, want)
+			return
+		}
+		for i := range list {
+			if list[i].kind != want[i].kind || !list[i].at.Equal(want[i].at) || list[i].percent != want[i].percent {
+				t.Errorf("%s: events = %v, want %v", name, list, want)
+				return
+			}
+		}
+	}
+
+	events, err := watch("alice", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("creator", events, all)
+	recorded, err := db.ListEvents(e.ID, 0)
+	if err != nil || len(recorded) != len(events) || recorded[1].ID != events[1].GetId() {
+		t.Fatalf("ListEvents = %+v, %v, want ids of %v", recorded, err, events)
+	}
+	events, err = watch("bob", events[1].GetId())
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("officer resuming", events, all[2:])
+	events, err = watch("carol", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("voter", events, []event{all[0], all[3], all[4]})
+
+	name, unknown := "e1", "e2"
+	err = s.WatchElection(&pb.WatchRequest{ElectionName: &name, Token: testToken(eVotingServer{key: testKey(2)}, "alice")}, &eventStream{ctx: ctx})
+	if pb.Reason(err) != pb.ReasonWatchElectionUnauthn {
+		t.Errorf("WatchElection with a token of another server = %v, want %s", err, pb.ReasonWatchElectionUnauthn)
+	}
+	err = s.WatchElection(&pb.WatchRequest{ElectionName: &unknown, Token: testToken(s, "alice")}, &eventStream{ctx: ctx})
+	if pb.Reason(err) != pb.ReasonWatchElectionNotFound {
+		t.Errorf("WatchElection of an unknown election = %v, want %s", err, pb.ReasonWatchElectionNotFound)
+	}
+}
+
+func TestCreateElectionBadThreshold(t *testing.T) {
+	db := openTestStore(t)
+	err := db.AddVoter(store.Voter{Name: "alice", Groups: []string{"g1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := eVotingServer{db: db, key: testKey(1)}
+	name, threshold := "e1", pb.Threshold(42)
+	_, err = s.CreateElection(context.Background(), &pb.Election{
+		Name: &name,
+		Groups: []string{"g1"},
+		Choices: []string{"a", "b"},
+		EndDate: timestamppb.New(time.Now().Add(time.Hour)),
+		Token: testToken(s, "alice"),
+		Threshold: &threshold,
+	})
+	if pb.Reason(err) != pb.ReasonCreateElectionBadThreshold {
+		t.Errorf("CreateElection with threshold %d = %v, want %s", threshold, err, pb.ReasonCreateElectionBadThreshold)
+	}
+}
+
+func TestJoin(t *testing.T) {
+	db := openTestStore(t)
+	s := syncServer{db: db}
+	primary, nodes = *syncAddr, []string{}
+	t.Cleanup(func() { primary, nodes = "", []string{} })
+
+	// nothing listens there to be told of nodes
+	address := "localhost:1"
+	latest := int32(store.LatestSchemaVersion())
+	older, newer := latest - 1, latest + 1
+	for _, version := range []*int32{nil, &older, &newer} {
+		_, err := s.Join(context.Background(), &pb.NodeIdentifier{Address: &address, SchemaVersion: version})
+		if pb.Reason(err) != pb.ReasonSyncSchemaMismatch {
+			t.Errorf("Join of schema version %v = %v, want %s", version, err, pb.ReasonSyncSchemaMismatch)
+		}
+	}
+	if len(nodes) != 0 {
+		t.Errorf("nodes = %v after turning away all", nodes)
+	}
+
+	dump, err := s.Join(context.Background(), &pb.NodeIdentifier{Address: &address, SchemaVersion: &latest})
+	if err != nil || dump.GetSchemaVersion() != latest {
+		t.Errorf("Join of the same schema version = %v, %v, want a dump of it", dump, err)
+	}
+	if !reflect.DeepEqual(nodes, []string{address}) {
+		t.Errorf("nodes = %v, want %v", nodes, []string{address})
+	}
+}
@@ -2,10 +2,19 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"path"
+	"strconv"
+	"strings"
+	"text/tabwriter"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	pb "github.com/xdavidwu/evoting/proto"
@@ -13,11 +22,27 @@ import (
 
 var (
 	addr = flag.String("server", "localhost:1234", "server address")
+	jsonOutput = flag.Bool("json", false, "output show and list in JSON")
 	help = `Usage: %s [GLOBAL FLAGS]... SUBCOMMAND
 
 Subcommands:
-  register NAME GROUP PUBLIC_KEY_FILE
+  register [--weight WEIGHT] NAME GROUP[,GROUP]... PUBLIC_KEY_FILE
   unregister NAME
+  join NAME GROUP
+  leave NAME GROUP
+  update NAME [--group GROUP[,GROUP]...] [--key PUBLIC_KEY_FILE] [--weight WEIGHT]
+  show NAME
+  list [--group GROUP]
+  import [--dry-run] CSV_FILE
+  export [--group GROUP] [CSV_FILE]
+
+CSV files have columns name, group, public_key and optionally weight, with an
+optional header. group may list several groups, separated by ",". public_key
+is either hex, base64, or the path to a public key file relative to the CSV
+file. Voters weigh 1 by default.
+
+Groups are hierarchical by '/', members of engineering/backend are members of
+engineering too.
 
 Global flags:
 `
@@ -44,24 +69,31 @@ func main() {
 	}
 	switch args[0] {
 	case "register":
-		if len(args) != 4 {
+		flags := flag.NewFlagSet("register", flag.ExitOnError)
+		weight := flags.Int64("weight", 0, "weight of ballots of the voter")
+		flags.Parse(args[1:])
+		args = flags.Args()
+		if len(args) != 3 {
 			flag.Usage()
 			log.Fatal("Invalid numer of arguments for register")
 		}
-		key, err := os.ReadFile(args[3])
+		key, err := os.ReadFile(args[2])
 		if err != nil {
 			log.Fatalf("fail to read public key: %v", err)
 		}
 
-		status, err := client.RegisterVoter(context.Background(), &pb.Voter{
-			Name: &args[1],
-			Group: &args[2],
+		groups := strings.Split(args[1], ",")
+		v := &pb.Voter{
+			Name: &args[0],
+			Group: &groups[0],
 			PublicKey: key,
-		})
-		if err != nil {
-			log.Fatalf("fail to register: %v", err)
+			Groups: groups[1:],
+		}
+		if *weight != 0 {
+			v.Weight = weight
 		}
-		if err = pb.RegisterVoterToError(status); err != nil {
+		err = pb.RegisterVoterToError(client.RegisterVoter(context.Background(), v))
+		if err != nil {
 			log.Fatalf("fail to register: %v", err)
 		}
 	case "unregister":
@@ -69,14 +101,286 @@ func main() {
 			flag.Usage()
 			log.Fatal("Invalid numer of arguments for unregister")
 		}
-		status, err := client.UnregisterVoter(context.Background(), &pb.VoterName{Name: &args[1]})
+		err = pb.UnregisterVoterToError(client.UnregisterVoter(context.Background(), &pb.VoterName{Name: &args[1]}))
 		if err != nil {
 			log.Fatalf("fail to unregister: %v", err)
 		}
-		if err = pb.UnregisterVoterToError(status); err != nil {
-			log.Fatalf("fail to unregister: %v", err)
+	case "join", "leave":
+		if len(args) != 3 {
+			flag.Usage()
+			log.Fatalf("Invalid numer of arguments for %s", args[0])
+		}
+		m := &pb.Membership{Name: &args[1], Group: &args[2]}
+		if args[0] == "join" {
+			err = pb.MembershipToError(client.AddMembership(context.Background(), m))
+		} else {
+			err = pb.MembershipToError(client.RemoveMembership(context.Background(), m))
+		}
+		if err != nil {
+			log.Fatalf("fail to %s: %v", args[0], err)
+		}
+	case "update":
+		if len(args) < 2 {
+			flag.Usage()
+			log.Fatal("Invalid numer of arguments for update")
+		}
+		flags := flag.NewFlagSet("update", flag.ExitOnError)
+		group := flags.String("group", "", "replace groups of the voter, comma-separated")
+		keyFile := flags.String("key", "", "replace public key of the voter")
+		weight := flags.Int64("weight", 0, "replace weight of ballots of the voter")
+		flags.Parse(args[2:])
+
+		update := &pb.VoterUpdate{Name: &args[1]}
+		if *group != "" {
+			update.Groups = strings.Split(*group, ",")
+		}
+		if *keyFile != "" {
+			update.PublicKey, err = os.ReadFile(*keyFile)
+			if err != nil {
+				log.Fatalf("fail to read public key: %v", err)
+			}
+		}
+		if *weight != 0 {
+			update.Weight = weight
+		}
+		err = pb.UpdateVoterToError(client.UpdateVoter(context.Background(), update))
+		if err != nil {
+			log.Fatalf("fail to update: %v", err)
+		}
+	case "show":
+		if len(args) != 2 {
+			flag.Usage()
+			log.Fatal("Invalid numer of arguments for show")
+		}
+		v, err := client.GetVoter(context.Background(), &pb.VoterName{Name: &args[1]})
+		if err != nil {
+			log.Fatalf("fail to show: %v", pb.ToError(err))
+		}
+		if *jsonOutput {
+			printJSON(voterJSON(v))
+			break
+		}
+		fmt.Printf("Name:\t%s\n", v.GetName())
+		fmt.Printf("Groups:\t%s\n", strings.Join(v.Groups, ", "))
+		fmt.Printf("Weight:\t%d\n", v.GetWeight())
+		fmt.Printf("Key:\t%s\n", hex.EncodeToString(v.PublicKey))
+	case "list":
+		flags := flag.NewFlagSet("list", flag.ExitOnError)
+		group := flags.String("group", "", "only members of the group, including its subgroups")
+		flags.Parse(args[1:])
+
+		req := &pb.ListVotersRequest{}
+		if *group != "" {
+			req.Group = group
+		}
+		voters := []voter{}
+		for {
+			list, err := client.ListVoters(context.Background(), req)
+			if err != nil {
+				log.Fatalf("fail to list: %v", pb.ToError(err))
+			}
+			for _, v := range list.Voters {
+				voters = append(voters, voterJSON(v))
+			}
+			if list.NextPageToken == nil {
+				break
+			}
+			req.PageToken = list.NextPageToken
+		}
+		if *jsonOutput {
+			printJSON(voters)
+			break
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tWEIGHT\tGROUPS")
+		for _, v := range voters {
+			fmt.Fprintf(w, "%s\t%d\t%s\n", v.Name, v.Weight, strings.Join(v.Groups, ","))
+		}
+		w.Flush()
+	case "import":
+		flags := flag.NewFlagSet("import", flag.ExitOnError)
+		dryRun := flags.Bool("dry-run", false, "check without registering")
+		flags.Parse(args[1:])
+		if flags.NArg() != 1 {
+			flag.Usage()
+			log.Fatal("Invalid numer of arguments for import")
+		}
+
+		voters, lines, err := readVoters(flags.Arg(0))
+		if err != nil {
+			log.Fatalf("fail to read voters: %v", err)
+		}
+		stream, err := client.RegisterVoters(context.Background())
+		if err != nil {
+			log.Fatalf("fail to import: %v", pb.ToError(err))
+		}
+		for _, v := range voters {
+			err = stream.Send(&pb.RegisterVotersRequest{Voter: v, DryRun: dryRun})
+			if err != nil {
+				break
+			}
+		}
+		// errors of Send surface here
+		res, err := stream.CloseAndRecv()
+		if err != nil {
+			log.Fatalf("fail to import: %v", pb.ToError(err))
+		}
+		for _, e := range res.Errors {
+			log.Printf("line %d (%s): %s", lines[e.GetIndex()], e.GetName(), pb.Message(e.GetReason()))
+		}
+		if len(res.Errors) != 0 {
+			log.Fatal("nothing imported")
+		}
+		if *dryRun {
+			fmt.Printf("%d voters would be registered\n", res.GetRegistered())
+		} else {
+			fmt.Printf("%d voters registered\n", res.GetRegistered())
+		}
+	case "export":
+		flags := flag.NewFlagSet("export", flag.ExitOnError)
+		group := flags.String("group", "", "only members of the group, including its subgroups")
+		flags.Parse(args[1:])
+		if flags.NArg() > 1 {
+			flag.Usage()
+			log.Fatal("Invalid numer of arguments for export")
+		}
+
+		out := os.Stdout
+		if flags.NArg() == 1 {
+			out, err = os.Create(flags.Arg(0))
+			if err != nil {
+				log.Fatalf("fail to create %s: %v", flags.Arg(0), err)
+			}
+			defer out.Close()
+		}
+		w := csv.NewWriter(out)
+		w.Write(csvHeader)
+		req := &pb.ListVotersRequest{}
+		if *group != "" {
+			req.Group = group
+		}
+		for {
+			list, err := client.ListVoters(context.Background(), req)
+			if err != nil {
+				log.Fatalf("fail to export: %v", pb.ToError(err))
+			}
+			for _, v := range list.Voters {
+				w.Write([]string{v.GetName(), strings.Join(v.Groups, ","), hex.EncodeToString(v.PublicKey), strconv.FormatInt(v.GetWeight(), 10)})
+			}
+			if list.NextPageToken == nil {
+				break
+			}
+			req.PageToken = list.NextPageToken
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			log.Fatalf("fail to export: %v", err)
 		}
 	default:
 		log.Fatalf("unknown subcommand %s", args[0])
 	}
 }
+
+type voter struct {
+	Name	string	`json:"name"`
+	Groups	[]string	`json:"groups"`
+	Weight	int64	`json:"weight"`
+	PublicKey	string	`json:"public_key,omitempty"`
+}
+
+func voterJSON(v *pb.VoterInfo) voter {
+	groups := v.Groups
+	if groups == nil {
+		groups = []string{}
+	}
+	return voter{
+		Name: v.GetName(),
+		Groups: groups,
+		Weight: v.GetWeight(),
+		PublicKey: hex.EncodeToString(v.PublicKey),
+	}
+}
+
+func printJSON(v any) {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	err := enc.Encode(v)
+	if err != nil {
+		log.Fatalf("fail to encode: %v", err)
+	}
+}
+
+var csvHeader = []string{"name", "group", "public_key", "weight"}
+
+const (
+	// crypto_sign_PUBLICKEYBYTES
+	publicKeyBytes = 32
+)
+
+// readVoters reads voters from a CSV file, and the line each is on.
+func readVoters(file string) ([]*pb.Voter, []int, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	r := csv.NewReader(f)
+	// weight is optional
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	voters := []*pb.Voter{}
+	lines := []int{}
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			return voters, lines, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		line, _ := r.FieldPos(0)
+		if len(record) != len(csvHeader) && len(record) != len(csvHeader) - 1 {
+			return nil, nil, fmt.Errorf("line %d: wrong number of fields", line)
+		}
+		if line == 1 && strings.Join(record, ",") == strings.Join(csvHeader[:len(record)], ",") {
+			continue
+		}
+
+		groups := strings.Split(record[1], ",")
+		key, err := parseKey(record[2], path.Dir(file))
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		v := &pb.Voter{
+			Name: &record[0],
+			Group: &groups[0],
+			PublicKey: key,
+			Groups: groups[1:],
+		}
+		if len(record) == len(csvHeader) && record[3] != "" {
+			weight, err := strconv.ParseInt(record[3], 10, 64)
+			if err != nil {
+				return nil, nil, fmt.Errorf("line %d: bad weight: %w", line, err)
+			}
+			v.Weight = &weight
+		}
+		voters = append(voters, v)
+		lines = append(lines, line)
+	}
+}
+
+// parseKey decodes a public key in hex or base64, or reads it from a file
+// relative to dir.
+func parseKey(s, dir string) ([]byte, error) {
+	if key, err := hex.DecodeString(s); err == nil && len(key) == publicKeyBytes {
+		return key, nil
+	}
+	if key, err := base64.StdEncoding.DecodeString(s); err == nil && len(key) == publicKeyBytes {
+		return key, nil
+	}
+	if !path.IsAbs(s) {
+		s = path.Join(dir, s)
+	}
+	return os.ReadFile(s)
+}
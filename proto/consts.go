@@ -9,6 +9,10 @@ const (
 	UnregisterVoterNotFound	int32 = 1
 	UnregisterVoterUnknown	int32 = 2
 
+	MembershipSuccess	int32 = 0
+
+	UpdateVoterSuccess	int32 = 0
+
 	CreateElectionSuccess	int32 = 0
 	CreateElectionUnauthn	int32 = 1
 	CreateElectionNoSpec	int32 = 2
@@ -23,4 +27,164 @@ const (
 	GetResultSuccess	int32 = 0
 	GetResultNotFound	int32 = 1
 	GetResultNotYet		int32 = 2
+
+	UpdateRollSuccess	int32 = 0
+
+	SnapshotSuccess	int32 = 0
+
+	DelegateSuccess	int32 = 0
+
+	AdjudicateWriteInsSuccess	int32 = 0
+
+	NominationSuccess	int32 = 0
+
+	ContributeToBeaconSuccess	int32 = 0
+
+	CertifyResultSuccess	int32 = 0
+)
+
+// ErrorDomain is the google.rpc.ErrorInfo domain of errors from the server.
+const ErrorDomain = "evoting.xdavidwu.github.com"
+
+// Reasons in google.rpc.ErrorInfo of errors from the server.
+const (
+	ReasonInternal	= "INTERNAL"
+	ReasonConflict	= "CONFLICT"
+	ReasonUnknown	= "UNKNOWN"
+
+	ReasonRegisterVoterExists	= "REGISTER_VOTER_EXISTS"
+	ReasonRegisterVoterBadGroup	= "REGISTER_VOTER_BAD_GROUP"
+	ReasonRegisterVoterBadKey	= "REGISTER_VOTER_BAD_KEY"
+	ReasonRegisterVoterBadWeight	= "REGISTER_VOTER_BAD_WEIGHT"
+
+	ReasonUnregisterVoterNotFound	= "UNREGISTER_VOTER_NOT_FOUND"
+
+	ReasonAddMembershipNotFound	= "ADD_MEMBERSHIP_NOT_FOUND"
+	ReasonAddMembershipExists	= "ADD_MEMBERSHIP_EXISTS"
+	ReasonAddMembershipBadGroup	= "ADD_MEMBERSHIP_BAD_GROUP"
+
+	ReasonRemoveMembershipNotFound	= "REMOVE_MEMBERSHIP_NOT_FOUND"
+
+	ReasonUpdateVoterNotFound	= "UPDATE_VOTER_NOT_FOUND"
+	ReasonUpdateVoterBadGroup	= "UPDATE_VOTER_BAD_GROUP"
+	ReasonUpdateVoterBadWeight	= "UPDATE_VOTER_BAD_WEIGHT"
+	ReasonUpdateVoterBadKey	= "UPDATE_VOTER_BAD_KEY"
+
+	ReasonGetVoterNotFound	= "GET_VOTER_NOT_FOUND"
+
+	ReasonAuthNotRegistered	= "AUTH_NOT_REGISTERED"
+	ReasonAuthBadSignature	= "AUTH_BAD_SIGNATURE"
+
+	ReasonCreateElectionUnauthn	= "CREATE_ELECTION_UNAUTHN"
+	ReasonCreateElectionNoSpec	= "CREATE_ELECTION_NO_SPEC"
+	ReasonCreateElectionExists	= "CREATE_ELECTION_EXISTS"
+	ReasonCreateElectionBadWeight	= "CREATE_ELECTION_BAD_WEIGHT"
+	ReasonCreateElectionBadQuorum	= "CREATE_ELECTION_BAD_QUORUM"
+	ReasonCreateElectionBadThreshold	= "CREATE_ELECTION_BAD_THRESHOLD"
+	ReasonCreateElectionBadQuestion	= "CREATE_ELECTION_BAD_QUESTION"
+	ReasonCreateElectionBadRunoff	= "CREATE_ELECTION_BAD_RUNOFF"
+	ReasonCreateElectionBadNominations	= "CREATE_ELECTION_BAD_NOMINATIONS"
+	ReasonCreateElectionBadBudget	= "CREATE_ELECTION_BAD_BUDGET"
+	ReasonCreateElectionBadTrustees	= "CREATE_ELECTION_BAD_TRUSTEES"
+	ReasonCreateElectionBadOfficers	= "CREATE_ELECTION_BAD_OFFICERS"
+
+	ReasonCastVoteUnauthn	= "CAST_VOTE_UNAUTHN"
+	ReasonCastVoteNotFound	= "CAST_VOTE_NOT_FOUND"
+	ReasonCastVoteUnauthz	= "CAST_VOTE_UNAUTHZ"
+	ReasonCastVoteExcluded	= "CAST_VOTE_EXCLUDED"
+	ReasonCastVoteNotInElectorate	= "CAST_VOTE_NOT_IN_ELECTORATE"
+	ReasonCastVoteAlready	= "CAST_VOTE_ALREADY"
+	ReasonCastVoteEnded	= "CAST_VOTE_ENDED"
+	ReasonCastVoteNotOpen	= "CAST_VOTE_NOT_OPEN"
+	ReasonCastVoteCancelled	= "CAST_VOTE_CANCELLED"
+	ReasonCastVoteNoSuchChoice	= "CAST_VOTE_NO_SUCH_CHOICE"
+	ReasonCastVoteNoSuchQuestion	= "CAST_VOTE_NO_SUCH_QUESTION"
+	ReasonCastVoteBadAnswer	= "CAST_VOTE_BAD_ANSWER"
+
+	ReasonGetResultNotFound	= "GET_RESULT_NOT_FOUND"
+	ReasonGetResultNotYet	= "GET_RESULT_NOT_YET"
+	ReasonGetResultCancelled	= "GET_RESULT_CANCELLED"
+
+	ReasonListElectionsUnauthn	= "LIST_ELECTIONS_UNAUTHN"
+	ReasonListElectionsBadPageToken	= "LIST_ELECTIONS_BAD_PAGE_TOKEN"
+
+	ReasonGetElectionNotFound	= "GET_ELECTION_NOT_FOUND"
+
+	ReasonUpdateRollUnauthn	= "UPDATE_ROLL_UNAUTHN"
+	ReasonUpdateRollNotFound	= "UPDATE_ROLL_NOT_FOUND"
+	ReasonUpdateRollUnauthz	= "UPDATE_ROLL_UNAUTHZ"
+	ReasonUpdateRollRunoff	= "UPDATE_ROLL_RUNOFF"
+
+	ReasonSnapshotUnauthn	= "SNAPSHOT_UNAUTHN"
+	ReasonSnapshotNotFound	= "SNAPSHOT_NOT_FOUND"
+	ReasonSnapshotUnauthz	= "SNAPSHOT_UNAUTHZ"
+	ReasonSnapshotEnded	= "SNAPSHOT_ENDED"
+	ReasonSnapshotRunoff	= "SNAPSHOT_RUNOFF"
+
+	ReasonAdjudicateWriteInsUnauthn	= "ADJUDICATE_WRITE_INS_UNAUTHN"
+	ReasonAdjudicateWriteInsNotFound	= "ADJUDICATE_WRITE_INS_NOT_FOUND"
+	ReasonAdjudicateWriteInsUnauthz	= "ADJUDICATE_WRITE_INS_UNAUTHZ"
+	ReasonAdjudicateWriteInsNotYet	= "ADJUDICATE_WRITE_INS_NOT_YET"
+	ReasonAdjudicateWriteInsNoSuchQuestion	= "ADJUDICATE_WRITE_INS_NO_SUCH_QUESTION"
+	ReasonAdjudicateWriteInsBadChoice	= "ADJUDICATE_WRITE_INS_BAD_CHOICE"
+
+	ReasonNominateUnauthn	= "NOMINATE_UNAUTHN"
+	ReasonNominateNotFound	= "NOMINATE_NOT_FOUND"
+	ReasonNominateClosed	= "NOMINATE_CLOSED"
+	ReasonNominateUnauthz	= "NOMINATE_UNAUTHZ"
+	ReasonNominateNoSuchNominee	= "NOMINATE_NO_SUCH_NOMINEE"
+	ReasonNominateExists	= "NOMINATE_EXISTS"
+
+	ReasonSecondNominationUnauthn	= "SECOND_NOMINATION_UNAUTHN"
+	ReasonSecondNominationNotFound	= "SECOND_NOMINATION_NOT_FOUND"
+	ReasonSecondNominationClosed	= "SECOND_NOMINATION_CLOSED"
+	ReasonSecondNominationUnauthz	= "SECOND_NOMINATION_UNAUTHZ"
+	ReasonSecondNominationOwn	= "SECOND_NOMINATION_OWN"
+	ReasonSecondNominationAlready	= "SECOND_NOMINATION_ALREADY"
+
+	ReasonRespondNominationUnauthn	= "RESPOND_NOMINATION_UNAUTHN"
+	ReasonRespondNominationNotFound	= "RESPOND_NOMINATION_NOT_FOUND"
+	ReasonRespondNominationClosed	= "RESPOND_NOMINATION_CLOSED"
+
+	ReasonListNominationsNotFound	= "LIST_NOMINATIONS_NOT_FOUND"
+
+	ReasonContributeToBeaconUnauthn	= "CONTRIBUTE_TO_BEACON_UNAUTHN"
+	ReasonContributeToBeaconNotFound	= "CONTRIBUTE_TO_BEACON_NOT_FOUND"
+	ReasonContributeToBeaconUnauthz	= "CONTRIBUTE_TO_BEACON_UNAUTHZ"
+	ReasonContributeToBeaconEnded	= "CONTRIBUTE_TO_BEACON_ENDED"
+	ReasonContributeToBeaconEmpty	= "CONTRIBUTE_TO_BEACON_EMPTY"
+	ReasonContributeToBeaconAlready	= "CONTRIBUTE_TO_BEACON_ALREADY"
+
+	ReasonCertifyResultUnauthn	= "CERTIFY_RESULT_UNAUTHN"
+	ReasonCertifyResultNotFound	= "CERTIFY_RESULT_NOT_FOUND"
+	ReasonCertifyResultNotYet	= "CERTIFY_RESULT_NOT_YET"
+	ReasonCertifyResultCancelled	= "CERTIFY_RESULT_CANCELLED"
+	ReasonCertifyResultUnauthz	= "CERTIFY_RESULT_UNAUTHZ"
+	ReasonCertifyResultBadSignature	= "CERTIFY_RESULT_BAD_SIGNATURE"
+
+	ReasonGetTurnoutUnauthn	= "GET_TURNOUT_UNAUTHN"
+	ReasonGetTurnoutNotFound	= "GET_TURNOUT_NOT_FOUND"
+	ReasonGetTurnoutUnauthz	= "GET_TURNOUT_UNAUTHZ"
+	ReasonGetTurnoutBadInterval	= "GET_TURNOUT_BAD_INTERVAL"
+
+	ReasonWatchElectionUnauthn	= "WATCH_ELECTION_UNAUTHN"
+	ReasonWatchElectionNotFound	= "WATCH_ELECTION_NOT_FOUND"
+
+	ReasonDelegateUnauthn	= "DELEGATE_UNAUTHN"
+	ReasonDelegateNoScope	= "DELEGATE_NO_SCOPE"
+	ReasonDelegateNotFound	= "DELEGATE_NOT_FOUND"
+	ReasonDelegateEnded	= "DELEGATE_ENDED"
+	ReasonDelegateSelf	= "DELEGATE_SELF"
+	ReasonDelegateNoSuchDelegate	= "DELEGATE_NO_SUCH_DELEGATE"
+	ReasonDelegateCycle	= "DELEGATE_CYCLE"
+
+	ReasonRevokeDelegationUnauthn	= "REVOKE_DELEGATION_UNAUTHN"
+	ReasonRevokeDelegationNoScope	= "REVOKE_DELEGATION_NO_SCOPE"
+	ReasonRevokeDelegationNotFound	= "REVOKE_DELEGATION_NOT_FOUND"
+	ReasonRevokeDelegationEnded	= "REVOKE_DELEGATION_ENDED"
+
+	ReasonSyncNotPrimary	= "SYNC_NOT_PRIMARY"
+	ReasonSyncIsPrimary	= "SYNC_IS_PRIMARY"
+	ReasonSyncSelfReplicated	= "SYNC_SELF_REPLICATED"
+	ReasonSyncSchemaMismatch	= "SYNC_SCHEMA_MISMATCH"
 )
@@ -1,68 +1,314 @@
 package proto
 
-import "errors"
+import (
+	"errors"
 
-func RegisterVoterToError(s *Status) error {
-	switch *s.Code {
-	case RegisterVoterSuccess:
-		return nil
-	case RegisterVoterExists:
-		return errors.New("Voter with the same name already exists")
-	default:
-		return errors.New("Undefined error")
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error is a failure reported by the server, either as a legacy Status code
+// or a gRPC status with google.rpc.ErrorInfo. Switch on Reason.
+type Error struct {
+	Code	codes.Code
+	Reason	string
+	Message	string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+var messages = map[string]string{
+	ReasonInternal:	"Internal server error",
+	ReasonConflict:	"Too many concurrent requests, try again",
+	ReasonUnknown:	"Undefined error",
+
+	ReasonRegisterVoterExists:	"Voter with the same name already exists",
+	ReasonRegisterVoterBadGroup:	"Invalid group name",
+	ReasonRegisterVoterBadKey:	"Invalid public key",
+	ReasonRegisterVoterBadWeight:	"Weight must be positive",
+
+	ReasonUnregisterVoterNotFound:	"No voter with the name exists on the server",
+
+	ReasonAddMembershipNotFound:	"No voter with the name exists on the server",
+	ReasonAddMembershipExists:	"The voter is already a member of the group",
+	ReasonAddMembershipBadGroup:	"Invalid group name",
+
+	ReasonRemoveMembershipNotFound:	"The voter is not a member of the group",
+
+	ReasonUpdateVoterNotFound:	"No voter with the name exists on the server",
+	ReasonUpdateVoterBadGroup:	"Invalid group name",
+	ReasonUpdateVoterBadWeight:	"Weight must be positive",
+	ReasonUpdateVoterBadKey:	"Invalid public key",
+
+	ReasonGetVoterNotFound:	"No voter with the name exists on the server",
+
+	ReasonAuthNotRegistered:	"Voter not registered",
+	ReasonAuthBadSignature:	"Unknown signature",
+
+	ReasonCreateElectionUnauthn:	"Invalid authentication token",
+	ReasonCreateElectionNoSpec:	"Missing groups or roll, or either choices or questions specification",
+	ReasonCreateElectionExists:	"Election with the same name already exists",
+	ReasonCreateElectionBadWeight:	"Group weights must be positive, on valid groups",
+	ReasonCreateElectionBadQuorum:	"Quorum must be a percentage",
+	ReasonCreateElectionBadThreshold:	"Unknown threshold",
+	ReasonCreateElectionBadQuestion:	"Questions need distinct choices, and a known ballot type; point-based ones a plurality threshold, points or credits to distribute if cumulative or quadratic, and no write-ins if Borda",
+	ReasonCreateElectionBadBudget:	"Budget questions need a positive budget, a known funding method, and positive costs of all choices, which can't be write-ins or nominees",
+	ReasonCreateElectionBadTrustees:	"Trustees must be registered voters",
+	ReasonCreateElectionBadOfficers:	"Certifying officers must be registered voters",
+	ReasonCreateElectionBadNominations:	"Nominations must end before the election, needing no negative number of seconds",
+	ReasonCreateElectionBadRunoff:	"Runoffs need a positive duration, and a single question of a single choice without write-ins",
+
+	ReasonCastVoteUnauthn:	"Invalid authentication token",
+	ReasonCastVoteNotFound:	"Invalid election name",
+	ReasonCastVoteUnauthz:	"The voter’s group is not allowed in the election, nor is the voter on its roll",
+	ReasonCastVoteExcluded:	"The voter is excluded from the election by its roll",
+	ReasonCastVoteNotInElectorate:	"The voter was not eligible when the electorate of the election was frozen",
+	ReasonCastVoteAlready:	"A previous vote has been cast",
+	ReasonCastVoteEnded:	"The election has ended",
+	ReasonCastVoteNotOpen:	"Voting has not opened yet, the election is taking nominations",
+	ReasonCastVoteCancelled:	"The election was cancelled as no nominee qualified",
+	ReasonCastVoteNoSuchChoice:	"No such choice in the question",
+	ReasonCastVoteNoSuchQuestion:	"No such question in the election",
+	ReasonCastVoteBadAnswer:	"Answer each question at most once, with one choice, or at least one distinct choice if not single, each given positive points within those of the question if cumulative, nonzero votes costing their squares within its credits if quadratic, or choices costing its budget at most if budgeting",
+
+	ReasonGetResultNotFound:	"Non-existent election",
+	ReasonGetResultNotYet:	"The election is still ongoing. Election result is not available yet.",
+	ReasonGetResultCancelled:	"The election was cancelled as no nominee qualified, it has no result",
+
+	ReasonListElectionsUnauthn:	"Invalid authentication token, needed for eligible or mine filters",
+	ReasonListElectionsBadPageToken:	"Invalid page token",
+
+	ReasonGetElectionNotFound:	"Non-existent election",
+
+	ReasonUpdateRollUnauthn:	"Invalid authentication token",
+	ReasonUpdateRollNotFound:	"Non-existent election",
+	ReasonUpdateRollUnauthz:	"Only the creator of the election may update its roll",
+	ReasonUpdateRollRunoff:	"Runoffs keep the electorate of their first round",
+
+	ReasonSnapshotUnauthn:	"Invalid authentication token",
+	ReasonSnapshotNotFound:	"Non-existent election",
+	ReasonSnapshotUnauthz:	"Only the creator of the election may freeze its electorate",
+	ReasonSnapshotEnded:	"The election has ended",
+	ReasonSnapshotRunoff:	"Runoffs keep the electorate of their first round",
+
+	ReasonAdjudicateWriteInsUnauthn:	"Invalid authentication token",
+	ReasonAdjudicateWriteInsNotFound:	"Non-existent election",
+	ReasonAdjudicateWriteInsUnauthz:	"Only the creator of the election may adjudicate its write-ins",
+	ReasonAdjudicateWriteInsNotYet:	"The election is still ongoing",
+	ReasonAdjudicateWriteInsNoSuchQuestion:	"No such question in the election",
+	ReasonAdjudicateWriteInsBadChoice:	"Write-ins can only be merged into non-empty choices",
+
+	ReasonNominateUnauthn:	"Invalid authentication token",
+	ReasonNominateNotFound:	"Non-existent election",
+	ReasonNominateClosed:	"The election is not taking nominations",
+	ReasonNominateUnauthz:	"Only voters in the electorate of the election may nominate",
+	ReasonNominateNoSuchNominee:	"The nominee is not registered",
+	ReasonNominateExists:	"The nominee has already been nominated",
+
+	ReasonSecondNominationUnauthn:	"Invalid authentication token",
+	ReasonSecondNominationNotFound:	"No such nomination, or non-existent election",
+	ReasonSecondNominationClosed:	"The election is not taking nominations",
+	ReasonSecondNominationUnauthz:	"Only voters in the electorate of the election may second",
+	ReasonSecondNominationOwn:	"Cannot second your own nomination, or one of you",
+	ReasonSecondNominationAlready:	"You have already seconded the nomination",
+
+	ReasonRespondNominationUnauthn:	"Invalid authentication token",
+	ReasonRespondNominationNotFound:	"You are not nominated, or non-existent election",
+	ReasonRespondNominationClosed:	"The election is not taking nominations",
+
+	ReasonListNominationsNotFound:	"Non-existent election",
+
+	ReasonContributeToBeaconUnauthn:	"Invalid authentication token",
+	ReasonContributeToBeaconNotFound:	"Non-existent election",
+	ReasonContributeToBeaconUnauthz:	"Only trustees of the election may contribute to its beacon",
+	ReasonContributeToBeaconEnded:	"The election has ended",
+	ReasonContributeToBeaconEmpty:	"The contribution is empty",
+	ReasonContributeToBeaconAlready:	"You have already contributed",
+
+	ReasonCertifyResultUnauthn:	"Invalid authentication token",
+	ReasonCertifyResultNotFound:	"Non-existent election",
+	ReasonCertifyResultNotYet:	"The election is still ongoing",
+	ReasonCertifyResultCancelled:	"The election was cancelled, it has no result",
+	ReasonCertifyResultUnauthz:	"Only certifying officers of the election may certify its result",
+	ReasonCertifyResultBadSignature:	"The signature is not of the current result document by your key",
+
+	ReasonGetTurnoutUnauthn:	"Invalid authentication token",
+	ReasonGetTurnoutNotFound:	"Non-existent election",
+	ReasonGetTurnoutUnauthz:	"Only the creator and certifying officers of the election may see its turnout",
+	ReasonGetTurnoutBadInterval:	"The interval must be at least a minute",
+
+	ReasonWatchElectionUnauthn:	"Invalid authentication token",
+	ReasonWatchElectionNotFound:	"Non-existent election",
+
+	ReasonDelegateUnauthn:	"Invalid authentication token",
+	ReasonDelegateNoScope:	"Exactly one of an election or a valid group is needed",
+	ReasonDelegateNotFound:	"Non-existent election",
+	ReasonDelegateEnded:	"The election has ended",
+	ReasonDelegateSelf:	"Cannot delegate to oneself",
+	ReasonDelegateNoSuchDelegate:	"The delegate is not registered",
+	ReasonDelegateCycle:	"The delegate delegates back to you, directly or not",
+
+	ReasonRevokeDelegationUnauthn:	"Invalid authentication token",
+	ReasonRevokeDelegationNoScope:	"Exactly one of an election or a valid group is needed",
+	ReasonRevokeDelegationNotFound:	"No such delegation, or non-existent election",
+	ReasonRevokeDelegationEnded:	"The election has ended",
+
+	ReasonSyncNotPrimary:	"Not the primary",
+	ReasonSyncIsPrimary:	"Primary does not take updates from others",
+	ReasonSyncSelfReplicated:	"Storage backend replicates by itself",
+	ReasonSyncSchemaMismatch:	"Database schema version differs from the primary's, upgrade to the same release",
+}
+
+// Message describes reason in English.
+func Message(reason string) string {
+	msg, ok := messages[reason]
+	if !ok {
+		return reason
 	}
+	return msg
 }
 
-func UnregisterVoterToError(s *Status) error {
-	switch *s.Code {
-	case UnregisterVoterSuccess:
-		return nil
-	case UnregisterVoterNotFound:
-		return errors.New("No voter with the name exists on the server")
-	default:
-		return errors.New("Undefined error")
+// NewError returns a gRPC status error with reason in its ErrorInfo.
+func NewError(c codes.Code, reason string) error {
+	s, err := status.New(c, Message(reason)).WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: ErrorDomain,
+	})
+	if err != nil {
+		panic(err)
 	}
+	return s.Err()
 }
 
-func CreateElectionToError(s *Status) error {
-	switch *s.Code {
-	case CreateElectionSuccess:
+// Reason returns the reason of an error from the server, "" if it has none,
+// e.g. transport errors.
+func Reason(err error) string {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Reason
+	}
+	if e = fromStatus(err); e != nil {
+		return e.Reason
+	}
+	return ""
+}
+
+func fromStatus(err error) *Error {
+	s, ok := status.FromError(err)
+	if !ok {
 		return nil
-	case CreateElectionUnauthn:
-		return errors.New("Invalid authentication token")
-	case CreateElectionNoSpec:
-		return errors.New("Missing groups or choices specification")
-	default:
-		return errors.New("Unknown error")
 	}
+	for _, d := range s.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Domain == ErrorDomain {
+			return &Error{Code: s.Code(), Reason: info.Reason, Message: s.Message()}
+		}
+	}
+	return nil
 }
 
-func CastVoteToError(s *Status) error {
-	switch *s.Code {
-	case CastVoteSuccess:
+func fromLegacy(code int32, success int32, reasons map[int32]string) error {
+	if code == success {
 		return nil
-	case CastVoteUnauthn:
-		return errors.New("Invalid authentication token")
-	case CastVoteNotFound:
-		return errors.New("Invalid election name")
-	case CastVoteUnauthz:
-		return errors.New("The voter’s group is not allowed in the election")
-	case CastVoteAlready:
-		return errors.New("A previous vote has been cast")
-	default:
-		return errors.New("Undefined error")
 	}
+	reason, ok := reasons[code]
+	if !ok {
+		reason = ReasonUnknown
+	}
+	return &Error{Code: codes.Unknown, Reason: reason, Message: messages[reason]}
+}
+
+// ToError turns an error from an RPC into an *Error, or returns it as is if
+// it did not come from the server's logic.
+func ToError(err error) error {
+	if e := fromStatus(err); e != nil {
+		return e
+	}
+	return err
+}
+
+// decode is ToError for RPCs that may also answer with legacy Status codes.
+func decode(s *Status, err error, success int32, reasons map[int32]string) error {
+	if err != nil {
+		return ToError(err)
+	}
+	return fromLegacy(*s.Code, success, reasons)
+}
+
+func RegisterVoterToError(s *Status, err error) error {
+	return decode(s, err, RegisterVoterSuccess, map[int32]string{
+		RegisterVoterExists: ReasonRegisterVoterExists,
+	})
+}
+
+func UnregisterVoterToError(s *Status, err error) error {
+	return decode(s, err, UnregisterVoterSuccess, map[int32]string{
+		UnregisterVoterNotFound: ReasonUnregisterVoterNotFound,
+	})
+}
+
+func MembershipToError(s *Status, err error) error {
+	return decode(s, err, MembershipSuccess, nil)
+}
+
+func UpdateVoterToError(s *Status, err error) error {
+	return decode(s, err, UpdateVoterSuccess, nil)
+}
+
+func CreateElectionToError(s *Status, err error) error {
+	return decode(s, err, CreateElectionSuccess, map[int32]string{
+		CreateElectionUnauthn: ReasonCreateElectionUnauthn,
+		CreateElectionNoSpec: ReasonCreateElectionNoSpec,
+	})
+}
+
+func CastVoteToError(s *Status, err error) error {
+	return decode(s, err, CastVoteSuccess, map[int32]string{
+		CastVoteUnauthn: ReasonCastVoteUnauthn,
+		CastVoteNotFound: ReasonCastVoteNotFound,
+		CastVoteUnauthz: ReasonCastVoteUnauthz,
+		CastVoteAlready: ReasonCastVoteAlready,
+	})
+}
+
+func UpdateRollToError(s *Status, err error) error {
+	return decode(s, err, UpdateRollSuccess, nil)
+}
+
+func SnapshotElectorateToError(s *Status, err error) error {
+	return decode(s, err, SnapshotSuccess, nil)
+}
+
+func DelegateToError(s *Status, err error) error {
+	return decode(s, err, DelegateSuccess, nil)
+}
+
+func AdjudicateWriteInsToError(s *Status, err error) error {
+	return decode(s, err, AdjudicateWriteInsSuccess, nil)
+}
+
+func NominationToError(s *Status, err error) error {
+	return decode(s, err, NominationSuccess, nil)
+}
+
+func ContributeToBeaconToError(s *Status, err error) error {
+	return decode(s, err, ContributeToBeaconSuccess, nil)
+}
+
+func CertifyResultToError(s *Status, err error) error {
+	return decode(s, err, CertifyResultSuccess, nil)
 }
 
-func GetResultToError(e *ElectionResult) (*ElectionResult, error) {
-	switch *e.Status {
-	case GetResultSuccess:
-		return e, nil
-	case GetResultNotFound:
-		return nil, errors.New("Non-existent election")
-	case GetResultNotYet:
-		return nil, errors.New("The election is still ongoing. Election result is not available yet.")
-	default:
-		return nil, errors.New("Undefined error")
+func GetResultToError(e *ElectionResult, err error) (*ElectionResult, error) {
+	code := e.GetStatus()
+	err = decode(&Status{Code: &code}, err, GetResultSuccess, map[int32]string{
+		GetResultNotFound: ReasonGetResultNotFound,
+		GetResultNotYet: ReasonGetResultNotYet,
+	})
+	if err != nil {
+		return nil, err
 	}
+	return e, nil
 }
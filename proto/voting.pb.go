@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -21,6 +22,359 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type BallotType int32
+
+const (
+	// one choice
+	BallotType_BALLOT_SINGLE BallotType = 0
+	// any number of choices, each with the full weight of the voter
+	BallotType_BALLOT_APPROVAL BallotType = 1
+	// choices given some of the points of the question each, all of them at
+	// most
+	BallotType_BALLOT_CUMULATIVE BallotType = 2
+	// choices ranked, the first of n choices getting n-1 points, the next
+	// n-2, and so on
+	BallotType_BALLOT_BORDA BallotType = 3
+	// choices given votes each, against them if negative, n votes costing n²
+	// of the voice credits of the question, all of them at most
+	BallotType_BALLOT_QUADRATIC BallotType = 4
+	// choices approved, costing the budget of the question at most
+	BallotType_BALLOT_BUDGET BallotType = 5
+)
+
+// Enum value maps for BallotType.
+var (
+	BallotType_name = map[int32]string{
+		0: "BALLOT_SINGLE",
+		1: "BALLOT_APPROVAL",
+		2: "BALLOT_CUMULATIVE",
+		3: "BALLOT_BORDA",
+		4: "BALLOT_QUADRATIC",
+		5: "BALLOT_BUDGET",
+	}
+	BallotType_value = map[string]int32{
+		"BALLOT_SINGLE":     0,
+		"BALLOT_APPROVAL":   1,
+		"BALLOT_CUMULATIVE": 2,
+		"BALLOT_BORDA":      3,
+		"BALLOT_QUADRATIC":  4,
+		"BALLOT_BUDGET":     5,
+	}
+)
+
+func (x BallotType) Enum() *BallotType {
+	p := new(BallotType)
+	*p = x
+	return p
+}
+
+func (x BallotType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BallotType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_voting_proto_enumTypes[0].Descriptor()
+}
+
+func (BallotType) Type() protoreflect.EnumType {
+	return &file_proto_voting_proto_enumTypes[0]
+}
+
+func (x BallotType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *BallotType) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = BallotType(num)
+	return nil
+}
+
+// Deprecated: Use BallotType.Descriptor instead.
+func (BallotType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{0}
+}
+
+// How choices of a BALLOT_BUDGET question are funded out of its budget.
+type Funding int32
+
+const (
+	// in order of weighted approvals, skipping those not fitting
+	Funding_FUNDING_GREEDY Funding = 0
+	// by the Method of Equal Shares, the budget split among voters by
+	// weight, then greedily with what is left
+	Funding_FUNDING_EQUAL_SHARES Funding = 1
+)
+
+// Enum value maps for Funding.
+var (
+	Funding_name = map[int32]string{
+		0: "FUNDING_GREEDY",
+		1: "FUNDING_EQUAL_SHARES",
+	}
+	Funding_value = map[string]int32{
+		"FUNDING_GREEDY":       0,
+		"FUNDING_EQUAL_SHARES": 1,
+	}
+)
+
+func (x Funding) Enum() *Funding {
+	p := new(Funding)
+	*p = x
+	return p
+}
+
+func (x Funding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Funding) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_voting_proto_enumTypes[1].Descriptor()
+}
+
+func (Funding) Type() protoreflect.EnumType {
+	return &file_proto_voting_proto_enumTypes[1]
+}
+
+func (x Funding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Funding) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Funding(num)
+	return nil
+}
+
+// Deprecated: Use Funding.Descriptor instead.
+func (Funding) EnumDescriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{1}
+}
+
+// What the leading choice needs to carry a question, by weighted votes, or
+// points for point-based ballot types, which only take THRESHOLD_PLURALITY.
+type Threshold int32
+
+const (
+	// more than any other choice
+	Threshold_THRESHOLD_PLURALITY Threshold = 0
+	// more than half of voters not abstaining
+	Threshold_THRESHOLD_SIMPLE_MAJORITY Threshold = 1
+	// at least two thirds of voters not abstaining
+	Threshold_THRESHOLD_TWO_THIRDS Threshold = 2
+	// more than half of electorate_weight
+	Threshold_THRESHOLD_ABSOLUTE_MAJORITY Threshold = 3
+)
+
+// Enum value maps for Threshold.
+var (
+	Threshold_name = map[int32]string{
+		0: "THRESHOLD_PLURALITY",
+		1: "THRESHOLD_SIMPLE_MAJORITY",
+		2: "THRESHOLD_TWO_THIRDS",
+		3: "THRESHOLD_ABSOLUTE_MAJORITY",
+	}
+	Threshold_value = map[string]int32{
+		"THRESHOLD_PLURALITY":         0,
+		"THRESHOLD_SIMPLE_MAJORITY":   1,
+		"THRESHOLD_TWO_THIRDS":        2,
+		"THRESHOLD_ABSOLUTE_MAJORITY": 3,
+	}
+)
+
+func (x Threshold) Enum() *Threshold {
+	p := new(Threshold)
+	*p = x
+	return p
+}
+
+func (x Threshold) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Threshold) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_voting_proto_enumTypes[2].Descriptor()
+}
+
+func (Threshold) Type() protoreflect.EnumType {
+	return &file_proto_voting_proto_enumTypes[2]
+}
+
+func (x Threshold) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Threshold) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Threshold(num)
+	return nil
+}
+
+// Deprecated: Use Threshold.Descriptor instead.
+func (Threshold) EnumDescriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{2}
+}
+
+type ElectionState int32
+
+const (
+	ElectionState_ELECTION_STATE_ANY ElectionState = 0
+	ElectionState_ELECTION_OPEN      ElectionState = 1
+	ElectionState_ELECTION_CLOSED    ElectionState = 2
+	// taking nominations, only in ElectionInfo
+	ElectionState_ELECTION_NOMINATING ElectionState = 3
+	// no nominee qualified for the first question, which takes no
+	// write-ins, so voting never opens; only in ElectionInfo
+	ElectionState_ELECTION_CANCELLED ElectionState = 4
+)
+
+// Enum value maps for ElectionState.
+var (
+	ElectionState_name = map[int32]string{
+		0: "ELECTION_STATE_ANY",
+		1: "ELECTION_OPEN",
+		2: "ELECTION_CLOSED",
+		3: "ELECTION_NOMINATING",
+		4: "ELECTION_CANCELLED",
+	}
+	ElectionState_value = map[string]int32{
+		"ELECTION_STATE_ANY":  0,
+		"ELECTION_OPEN":       1,
+		"ELECTION_CLOSED":     2,
+		"ELECTION_NOMINATING": 3,
+		"ELECTION_CANCELLED":  4,
+	}
+)
+
+func (x ElectionState) Enum() *ElectionState {
+	p := new(ElectionState)
+	*p = x
+	return p
+}
+
+func (x ElectionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ElectionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_voting_proto_enumTypes[3].Descriptor()
+}
+
+func (ElectionState) Type() protoreflect.EnumType {
+	return &file_proto_voting_proto_enumTypes[3]
+}
+
+func (x ElectionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *ElectionState) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = ElectionState(num)
+	return nil
+}
+
+// Deprecated: Use ElectionState.Descriptor instead.
+func (ElectionState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{3}
+}
+
+// There is no kind for voting extended, as end dates are fixed once an
+// election is created. Runoffs are elections of their own, with events of
+// their own.
+type EventKind int32
+
+const (
+	// voting opened
+	EventKind_EVENT_OPENED EventKind = 0
+	// turnout reached percent of electorate_weight, a milestone or the
+	// quorum
+	EventKind_EVENT_TURNOUT EventKind = 1
+	// voting closed
+	EventKind_EVENT_CLOSED EventKind = 2
+	// GetResult is available, or changed by adjudication of write-ins
+	EventKind_EVENT_RESULT EventKind = 3
+	// officer countersigned the result
+	EventKind_EVENT_CERTIFIED EventKind = 4
+	// no nominee qualified, instead of EVENT_OPENED and all after
+	EventKind_EVENT_CANCELLED EventKind = 5
+)
+
+// Enum value maps for EventKind.
+var (
+	EventKind_name = map[int32]string{
+		0: "EVENT_OPENED",
+		1: "EVENT_TURNOUT",
+		2: "EVENT_CLOSED",
+		3: "EVENT_RESULT",
+		4: "EVENT_CERTIFIED",
+		5: "EVENT_CANCELLED",
+	}
+	EventKind_value = map[string]int32{
+		"EVENT_OPENED":    0,
+		"EVENT_TURNOUT":   1,
+		"EVENT_CLOSED":    2,
+		"EVENT_RESULT":    3,
+		"EVENT_CERTIFIED": 4,
+		"EVENT_CANCELLED": 5,
+	}
+)
+
+func (x EventKind) Enum() *EventKind {
+	p := new(EventKind)
+	*p = x
+	return p
+}
+
+func (x EventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_voting_proto_enumTypes[4].Descriptor()
+}
+
+func (EventKind) Type() protoreflect.EnumType {
+	return &file_proto_voting_proto_enumTypes[4]
+}
+
+func (x EventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *EventKind) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = EventKind(num)
+	return nil
+}
+
+// Deprecated: Use EventKind.Descriptor instead.
+func (EventKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{4}
+}
+
+// Groups are hierarchical by '/', e.g. engineering/backend is a subgroup of
+// engineering, and its members are members of engineering too.
 type Voter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -29,6 +383,10 @@ type Voter struct {
 	Name      *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	Group     *string `protobuf:"bytes,2,req,name=group" json:"group,omitempty"`
 	PublicKey []byte  `protobuf:"bytes,3,req,name=public_key,json=publicKey" json:"public_key,omitempty"`
+	// further groups besides group
+	Groups []string `protobuf:"bytes,4,rep,name=groups" json:"groups,omitempty"`
+	// of ballots of the voter, 1 if unset
+	Weight *int64 `protobuf:"varint,5,opt,name=weight" json:"weight,omitempty"`
 }
 
 func (x *Voter) Reset() {
@@ -84,16 +442,31 @@ func (x *Voter) GetPublicKey() []byte {
 	return nil
 }
 
-type VoterName struct {
+func (x *Voter) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *Voter) GetWeight() int64 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+type Membership struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Name  *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Group *string `protobuf:"bytes,2,req,name=group" json:"group,omitempty"`
 }
 
-func (x *VoterName) Reset() {
-	*x = VoterName{}
+func (x *Membership) Reset() {
+	*x = Membership{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_voting_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -101,13 +474,13 @@ func (x *VoterName) Reset() {
 	}
 }
 
-func (x *VoterName) String() string {
+func (x *Membership) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VoterName) ProtoMessage() {}
+func (*Membership) ProtoMessage() {}
 
-func (x *VoterName) ProtoReflect() protoreflect.Message {
+func (x *Membership) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_voting_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -119,28 +492,37 @@ func (x *VoterName) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VoterName.ProtoReflect.Descriptor instead.
-func (*VoterName) Descriptor() ([]byte, []int) {
+// Deprecated: Use Membership.ProtoReflect.Descriptor instead.
+func (*Membership) Descriptor() ([]byte, []int) {
 	return file_proto_voting_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *VoterName) GetName() string {
+func (x *Membership) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
 	}
 	return ""
 }
 
-type Status struct {
+func (x *Membership) GetGroup() string {
+	if x != nil && x.Group != nil {
+		return *x.Group
+	}
+	return ""
+}
+
+type RegisterVotersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code *int32 `protobuf:"varint,1,req,name=code" json:"code,omitempty"`
+	Voter *Voter `protobuf:"bytes,1,req,name=voter" json:"voter,omitempty"`
+	// only that of the first request counts, check without registering
+	DryRun *bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun" json:"dry_run,omitempty"`
 }
 
-func (x *Status) Reset() {
-	*x = Status{}
+func (x *RegisterVotersRequest) Reset() {
+	*x = RegisterVotersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_voting_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -148,13 +530,13 @@ func (x *Status) Reset() {
 	}
 }
 
-func (x *Status) String() string {
+func (x *RegisterVotersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Status) ProtoMessage() {}
+func (*RegisterVotersRequest) ProtoMessage() {}
 
-func (x *Status) ProtoReflect() protoreflect.Message {
+func (x *RegisterVotersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_voting_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -166,28 +548,38 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Status.ProtoReflect.Descriptor instead.
-func (*Status) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegisterVotersRequest.ProtoReflect.Descriptor instead.
+func (*RegisterVotersRequest) Descriptor() ([]byte, []int) {
 	return file_proto_voting_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Status) GetCode() int32 {
-	if x != nil && x.Code != nil {
-		return *x.Code
+func (x *RegisterVotersRequest) GetVoter() *Voter {
+	if x != nil {
+		return x.Voter
 	}
-	return 0
+	return nil
 }
 
-type Challenge struct {
+func (x *RegisterVotersRequest) GetDryRun() bool {
+	if x != nil && x.DryRun != nil {
+		return *x.DryRun
+	}
+	return false
+}
+
+// Voters are registered all at once, or none of them if any fails.
+type RegisterVotersResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value []byte `protobuf:"bytes,1,req,name=value" json:"value,omitempty"`
+	// registered, or would be in a dry run
+	Registered *int32        `protobuf:"varint,1,opt,name=registered" json:"registered,omitempty"`
+	Errors     []*VoterError `protobuf:"bytes,2,rep,name=errors" json:"errors,omitempty"`
 }
 
-func (x *Challenge) Reset() {
-	*x = Challenge{}
+func (x *RegisterVotersResult) Reset() {
+	*x = RegisterVotersResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_voting_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -195,13 +587,13 @@ func (x *Challenge) Reset() {
 	}
 }
 
-func (x *Challenge) String() string {
+func (x *RegisterVotersResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Challenge) ProtoMessage() {}
+func (*RegisterVotersResult) ProtoMessage() {}
 
-func (x *Challenge) ProtoReflect() protoreflect.Message {
+func (x *RegisterVotersResult) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_voting_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -213,28 +605,39 @@ func (x *Challenge) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Challenge.ProtoReflect.Descriptor instead.
-func (*Challenge) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegisterVotersResult.ProtoReflect.Descriptor instead.
+func (*RegisterVotersResult) Descriptor() ([]byte, []int) {
 	return file_proto_voting_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Challenge) GetValue() []byte {
+func (x *RegisterVotersResult) GetRegistered() int32 {
+	if x != nil && x.Registered != nil {
+		return *x.Registered
+	}
+	return 0
+}
+
+func (x *RegisterVotersResult) GetErrors() []*VoterError {
 	if x != nil {
-		return x.Value
+		return x.Errors
 	}
 	return nil
 }
 
-type Response struct {
+type VoterError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value []byte `protobuf:"bytes,1,req,name=value" json:"value,omitempty"`
+	// position of the voter in the stream, from 0
+	Index *int32  `protobuf:"varint,1,req,name=index" json:"index,omitempty"`
+	Name  *string `protobuf:"bytes,2,req,name=name" json:"name,omitempty"`
+	// as in google.rpc.ErrorInfo
+	Reason *string `protobuf:"bytes,3,req,name=reason" json:"reason,omitempty"`
 }
 
-func (x *Response) Reset() {
-	*x = Response{}
+func (x *VoterError) Reset() {
+	*x = VoterError{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_voting_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -242,13 +645,13 @@ func (x *Response) Reset() {
 	}
 }
 
-func (x *Response) String() string {
+func (x *VoterError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Response) ProtoMessage() {}
+func (*VoterError) ProtoMessage() {}
 
-func (x *Response) ProtoReflect() protoreflect.Message {
+func (x *VoterError) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_voting_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -260,29 +663,46 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Response.ProtoReflect.Descriptor instead.
-func (*Response) Descriptor() ([]byte, []int) {
+// Deprecated: Use VoterError.ProtoReflect.Descriptor instead.
+func (*VoterError) Descriptor() ([]byte, []int) {
 	return file_proto_voting_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Response) GetValue() []byte {
-	if x != nil {
-		return x.Value
+func (x *VoterError) GetIndex() int32 {
+	if x != nil && x.Index != nil {
+		return *x.Index
 	}
-	return nil
+	return 0
 }
 
-type AuthRequest struct {
+func (x *VoterError) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *VoterError) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type VoterUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     *VoterName `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Response *Response  `protobuf:"bytes,2,req,name=response" json:"response,omitempty"`
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	// replaces all groups of the voter, unchanged if empty
+	Groups    []string `protobuf:"bytes,2,rep,name=groups" json:"groups,omitempty"`
+	PublicKey []byte   `protobuf:"bytes,3,opt,name=public_key,json=publicKey" json:"public_key,omitempty"`
+	Weight    *int64   `protobuf:"varint,4,opt,name=weight" json:"weight,omitempty"`
 }
 
-func (x *AuthRequest) Reset() {
-	*x = AuthRequest{}
+func (x *VoterUpdate) Reset() {
+	*x = VoterUpdate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_voting_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -290,13 +710,13 @@ func (x *AuthRequest) Reset() {
 	}
 }
 
-func (x *AuthRequest) String() string {
+func (x *VoterUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthRequest) ProtoMessage() {}
+func (*VoterUpdate) ProtoMessage() {}
 
-func (x *AuthRequest) ProtoReflect() protoreflect.Message {
+func (x *VoterUpdate) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_voting_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -308,35 +728,52 @@ func (x *AuthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthRequest.ProtoReflect.Descriptor instead.
-func (*AuthRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VoterUpdate.ProtoReflect.Descriptor instead.
+func (*VoterUpdate) Descriptor() ([]byte, []int) {
 	return file_proto_voting_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AuthRequest) GetName() *VoterName {
+func (x *VoterUpdate) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *VoterUpdate) GetGroups() []string {
 	if x != nil {
-		return x.Name
+		return x.Groups
 	}
 	return nil
 }
 
-func (x *AuthRequest) GetResponse() *Response {
+func (x *VoterUpdate) GetPublicKey() []byte {
 	if x != nil {
-		return x.Response
+		return x.PublicKey
 	}
 	return nil
 }
 
-type AuthToken struct {
+func (x *VoterUpdate) GetWeight() int64 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+type VoterInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value []byte `protobuf:"bytes,1,req,name=value" json:"value,omitempty"`
+	Name      *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Groups    []string `protobuf:"bytes,2,rep,name=groups" json:"groups,omitempty"`
+	PublicKey []byte   `protobuf:"bytes,3,opt,name=public_key,json=publicKey" json:"public_key,omitempty"`
+	Weight    *int64   `protobuf:"varint,4,opt,name=weight" json:"weight,omitempty"`
 }
 
-func (x *AuthToken) Reset() {
-	*x = AuthToken{}
+func (x *VoterInfo) Reset() {
+	*x = VoterInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_voting_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -344,13 +781,13 @@ func (x *AuthToken) Reset() {
 	}
 }
 
-func (x *AuthToken) String() string {
+func (x *VoterInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthToken) ProtoMessage() {}
+func (*VoterInfo) ProtoMessage() {}
 
-func (x *AuthToken) ProtoReflect() protoreflect.Message {
+func (x *VoterInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_voting_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -362,32 +799,52 @@ func (x *AuthToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthToken.ProtoReflect.Descriptor instead.
-func (*AuthToken) Descriptor() ([]byte, []int) {
+// Deprecated: Use VoterInfo.ProtoReflect.Descriptor instead.
+func (*VoterInfo) Descriptor() ([]byte, []int) {
 	return file_proto_voting_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *AuthToken) GetValue() []byte {
-	if x != nil {
-		return x.Value
+func (x *VoterInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
-	return nil
+	return ""
 }
 
-type Election struct {
+func (x *VoterInfo) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *VoterInfo) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *VoterInfo) GetWeight() int64 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+type ListVotersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    *string                `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Groups  []string               `protobuf:"bytes,2,rep,name=groups" json:"groups,omitempty"`
-	Choices []string               `protobuf:"bytes,3,rep,name=choices" json:"choices,omitempty"`
-	EndDate *timestamppb.Timestamp `protobuf:"bytes,4,req,name=end_date,json=endDate" json:"end_date,omitempty"`
-	Token   *AuthToken             `protobuf:"bytes,5,req,name=token" json:"token,omitempty"`
+	PageSize  *int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	PageToken *string `protobuf:"bytes,2,opt,name=page_token,json=pageToken" json:"page_token,omitempty"`
+	// only members of the group, including its subgroups
+	Group *string `protobuf:"bytes,3,opt,name=group" json:"group,omitempty"`
 }
 
-func (x *Election) Reset() {
-	*x = Election{}
+func (x *ListVotersRequest) Reset() {
+	*x = ListVotersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_voting_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -395,13 +852,13 @@ func (x *Election) Reset() {
 	}
 }
 
-func (x *Election) String() string {
+func (x *ListVotersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Election) ProtoMessage() {}
+func (*ListVotersRequest) ProtoMessage() {}
 
-func (x *Election) ProtoReflect() protoreflect.Message {
+func (x *ListVotersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_voting_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -413,58 +870,43 @@ func (x *Election) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Election.ProtoReflect.Descriptor instead.
-func (*Election) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListVotersRequest.ProtoReflect.Descriptor instead.
+func (*ListVotersRequest) Descriptor() ([]byte, []int) {
 	return file_proto_voting_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Election) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-func (x *Election) GetGroups() []string {
-	if x != nil {
-		return x.Groups
-	}
-	return nil
-}
-
-func (x *Election) GetChoices() []string {
-	if x != nil {
-		return x.Choices
+func (x *ListVotersRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *Election) GetEndDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndDate
+func (x *ListVotersRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
 	}
-	return nil
+	return ""
 }
 
-func (x *Election) GetToken() *AuthToken {
-	if x != nil {
-		return x.Token
+func (x *ListVotersRequest) GetGroup() string {
+	if x != nil && x.Group != nil {
+		return *x.Group
 	}
-	return nil
+	return ""
 }
 
-type Vote struct {
+type VoterList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ElectionName *string    `protobuf:"bytes,1,req,name=election_name,json=electionName" json:"election_name,omitempty"`
-	ChoiceName   *string    `protobuf:"bytes,2,req,name=choice_name,json=choiceName" json:"choice_name,omitempty"`
-	Token        *AuthToken `protobuf:"bytes,3,req,name=token" json:"token,omitempty"`
+	Voters        []*VoterInfo `protobuf:"bytes,1,rep,name=voters" json:"voters,omitempty"`
+	NextPageToken *string      `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
 }
 
-func (x *Vote) Reset() {
-	*x = Vote{}
+func (x *VoterList) Reset() {
+	*x = VoterList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_voting_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -472,13 +914,13 @@ func (x *Vote) Reset() {
 	}
 }
 
-func (x *Vote) String() string {
+func (x *VoterList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Vote) ProtoMessage() {}
+func (*VoterList) ProtoMessage() {}
 
-func (x *Vote) ProtoReflect() protoreflect.Message {
+func (x *VoterList) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_voting_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -490,33 +932,26 @@ func (x *Vote) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Vote.ProtoReflect.Descriptor instead.
-func (*Vote) Descriptor() ([]byte, []int) {
+// Deprecated: Use VoterList.ProtoReflect.Descriptor instead.
+func (*VoterList) Descriptor() ([]byte, []int) {
 	return file_proto_voting_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Vote) GetElectionName() string {
-	if x != nil && x.ElectionName != nil {
-		return *x.ElectionName
+func (x *VoterList) GetVoters() []*VoterInfo {
+	if x != nil {
+		return x.Voters
 	}
-	return ""
+	return nil
 }
 
-func (x *Vote) GetChoiceName() string {
-	if x != nil && x.ChoiceName != nil {
-		return *x.ChoiceName
+func (x *VoterList) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
 	}
 	return ""
 }
 
-func (x *Vote) GetToken() *AuthToken {
-	if x != nil {
-		return x.Token
-	}
-	return nil
-}
-
-type ElectionName struct {
+type VoterName struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -524,8 +959,8 @@ type ElectionName struct {
 	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 }
 
-func (x *ElectionName) Reset() {
-	*x = ElectionName{}
+func (x *VoterName) Reset() {
+	*x = VoterName{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_voting_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -533,13 +968,13 @@ func (x *ElectionName) Reset() {
 	}
 }
 
-func (x *ElectionName) String() string {
+func (x *VoterName) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ElectionName) ProtoMessage() {}
+func (*VoterName) ProtoMessage() {}
 
-func (x *ElectionName) ProtoReflect() protoreflect.Message {
+func (x *VoterName) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_voting_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -551,29 +986,30 @@ func (x *ElectionName) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ElectionName.ProtoReflect.Descriptor instead.
-func (*ElectionName) Descriptor() ([]byte, []int) {
+// Deprecated: Use VoterName.ProtoReflect.Descriptor instead.
+func (*VoterName) Descriptor() ([]byte, []int) {
 	return file_proto_voting_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ElectionName) GetName() string {
+func (x *VoterName) GetName() string {
 	if x != nil && x.Name != nil {
 		return *x.Name
 	}
 	return ""
 }
 
-type VoteCount struct {
+type Status struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChoiceName *string `protobuf:"bytes,1,req,name=choice_name,json=choiceName" json:"choice_name,omitempty"`
-	Count      *int32  `protobuf:"varint,2,req,name=count" json:"count,omitempty"`
+	Code *int32 `protobuf:"varint,1,req,name=code" json:"code,omitempty"`
+	// CastVote only, a previous ballot was replaced
+	Replaced *bool `protobuf:"varint,2,opt,name=replaced" json:"replaced,omitempty"`
 }
 
-func (x *VoteCount) Reset() {
-	*x = VoteCount{}
+func (x *Status) Reset() {
+	*x = Status{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_voting_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -581,13 +1017,13 @@ func (x *VoteCount) Reset() {
 	}
 }
 
-func (x *VoteCount) String() string {
+func (x *Status) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VoteCount) ProtoMessage() {}
+func (*Status) ProtoMessage() {}
 
-func (x *VoteCount) ProtoReflect() protoreflect.Message {
+func (x *Status) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_voting_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -599,36 +1035,35 @@ func (x *VoteCount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VoteCount.ProtoReflect.Descriptor instead.
-func (*VoteCount) Descriptor() ([]byte, []int) {
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
 	return file_proto_voting_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *VoteCount) GetChoiceName() string {
-	if x != nil && x.ChoiceName != nil {
-		return *x.ChoiceName
+func (x *Status) GetCode() int32 {
+	if x != nil && x.Code != nil {
+		return *x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *VoteCount) GetCount() int32 {
-	if x != nil && x.Count != nil {
-		return *x.Count
+func (x *Status) GetReplaced() bool {
+	if x != nil && x.Replaced != nil {
+		return *x.Replaced
 	}
-	return 0
+	return false
 }
 
-type ElectionResult struct {
+type Challenge struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *int32       `protobuf:"varint,1,req,name=status" json:"status,omitempty"`
-	Counts []*VoteCount `protobuf:"bytes,2,rep,name=counts" json:"counts,omitempty"`
+	Value []byte `protobuf:"bytes,1,req,name=value" json:"value,omitempty"`
 }
 
-func (x *ElectionResult) Reset() {
-	*x = ElectionResult{}
+func (x *Challenge) Reset() {
+	*x = Challenge{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_voting_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -636,13 +1071,13 @@ func (x *ElectionResult) Reset() {
 	}
 }
 
-func (x *ElectionResult) String() string {
+func (x *Challenge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ElectionResult) ProtoMessage() {}
+func (*Challenge) ProtoMessage() {}
 
-func (x *ElectionResult) ProtoReflect() protoreflect.Message {
+func (x *Challenge) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_voting_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -654,33 +1089,28 @@ func (x *ElectionResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ElectionResult.ProtoReflect.Descriptor instead.
-func (*ElectionResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use Challenge.ProtoReflect.Descriptor instead.
+func (*Challenge) Descriptor() ([]byte, []int) {
 	return file_proto_voting_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ElectionResult) GetStatus() int32 {
-	if x != nil && x.Status != nil {
-		return *x.Status
-	}
-	return 0
-}
-
-func (x *ElectionResult) GetCounts() []*VoteCount {
+func (x *Challenge) GetValue() []byte {
 	if x != nil {
-		return x.Counts
+		return x.Value
 	}
 	return nil
 }
 
-type Empty struct {
+type Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Value []byte `protobuf:"bytes,1,req,name=value" json:"value,omitempty"`
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
+func (x *Response) Reset() {
+	*x = Response{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_voting_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -688,13 +1118,13 @@ func (x *Empty) Reset() {
 	}
 }
 
-func (x *Empty) String() string {
+func (x *Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*Response) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
+func (x *Response) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_voting_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -706,22 +1136,29 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
 	return file_proto_voting_proto_rawDescGZIP(), []int{12}
 }
 
-type NodesList struct {
+func (x *Response) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type AuthRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Primary *NodeIdentifier   `protobuf:"bytes,1,req,name=primary" json:"primary,omitempty"`
-	Nodes   []*NodeIdentifier `protobuf:"bytes,2,rep,name=nodes" json:"nodes,omitempty"`
+	Name     *VoterName `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Response *Response  `protobuf:"bytes,2,req,name=response" json:"response,omitempty"`
 }
 
-func (x *NodesList) Reset() {
-	*x = NodesList{}
+func (x *AuthRequest) Reset() {
+	*x = AuthRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_voting_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -729,13 +1166,13 @@ func (x *NodesList) Reset() {
 	}
 }
 
-func (x *NodesList) String() string {
+func (x *AuthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodesList) ProtoMessage() {}
+func (*AuthRequest) ProtoMessage() {}
 
-func (x *NodesList) ProtoReflect() protoreflect.Message {
+func (x *AuthRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_voting_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -747,35 +1184,35 @@ func (x *NodesList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodesList.ProtoReflect.Descriptor instead.
-func (*NodesList) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthRequest.ProtoReflect.Descriptor instead.
+func (*AuthRequest) Descriptor() ([]byte, []int) {
 	return file_proto_voting_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *NodesList) GetPrimary() *NodeIdentifier {
+func (x *AuthRequest) GetName() *VoterName {
 	if x != nil {
-		return x.Primary
+		return x.Name
 	}
 	return nil
 }
 
-func (x *NodesList) GetNodes() []*NodeIdentifier {
+func (x *AuthRequest) GetResponse() *Response {
 	if x != nil {
-		return x.Nodes
+		return x.Response
 	}
 	return nil
 }
 
-type NodeIdentifier struct {
+type AuthToken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address *string `protobuf:"bytes,1,req,name=address" json:"address,omitempty"`
+	Value []byte `protobuf:"bytes,1,req,name=value" json:"value,omitempty"`
 }
 
-func (x *NodeIdentifier) Reset() {
-	*x = NodeIdentifier{}
+func (x *AuthToken) Reset() {
+	*x = AuthToken{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_voting_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -783,13 +1220,13 @@ func (x *NodeIdentifier) Reset() {
 	}
 }
 
-func (x *NodeIdentifier) String() string {
+func (x *AuthToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeIdentifier) ProtoMessage() {}
+func (*AuthToken) ProtoMessage() {}
 
-func (x *NodeIdentifier) ProtoReflect() protoreflect.Message {
+func (x *AuthToken) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_voting_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -801,29 +1238,65 @@ func (x *NodeIdentifier) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeIdentifier.ProtoReflect.Descriptor instead.
-func (*NodeIdentifier) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthToken.ProtoReflect.Descriptor instead.
+func (*AuthToken) Descriptor() ([]byte, []int) {
 	return file_proto_voting_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *NodeIdentifier) GetAddress() string {
-	if x != nil && x.Address != nil {
-		return *x.Address
+func (x *AuthToken) GetValue() []byte {
+	if x != nil {
+		return x.Value
 	}
-	return ""
+	return nil
 }
 
-type Key struct {
+type Election struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Key  []byte  `protobuf:"bytes,2,req,name=key" json:"key,omitempty"`
+	Name   *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Groups []string `protobuf:"bytes,2,rep,name=groups" json:"groups,omitempty"`
+	// a single untitled question, exclusive with questions
+	Choices []string               `protobuf:"bytes,3,rep,name=choices" json:"choices,omitempty"`
+	EndDate *timestamppb.Timestamp `protobuf:"bytes,4,req,name=end_date,json=endDate" json:"end_date,omitempty"`
+	Token   *AuthToken             `protobuf:"bytes,5,req,name=token" json:"token,omitempty"`
+	// on top of groups
+	Roll         []*RollEntry   `protobuf:"bytes,6,rep,name=roll" json:"roll,omitempty"`
+	GroupWeights []*GroupWeight `protobuf:"bytes,7,rep,name=group_weights,json=groupWeights" json:"group_weights,omitempty"`
+	// voters may vote again until end_date, the last ballot counts
+	AllowRevote *bool `protobuf:"varint,8,opt,name=allow_revote,json=allowRevote" json:"allow_revote,omitempty"`
+	// minimum turnout, by proxy or not, in percent of electorate_weight
+	Quorum *int32 `protobuf:"varint,9,opt,name=quorum" json:"quorum,omitempty"`
+	// of the question from choices
+	Threshold *Threshold  `protobuf:"varint,10,opt,name=threshold,enum=voting.Threshold" json:"threshold,omitempty"`
+	Questions []*Question `protobuf:"bytes,11,rep,name=questions" json:"questions,omitempty"`
+	// with a single question of BALLOT_SINGLE, if no choice gets more than
+	// half of weighted votes not abstaining, a runoff between the top two
+	// is created at end_date, lasting this long, for the electorate as
+	// frozen for this election; none if the quorum is not met, as the
+	// result doesn't stand then
+	Runoff *durationpb.Duration `protobuf:"bytes,12,opt,name=runoff" json:"runoff,omitempty"`
+	// of the question from choices
+	AllowWriteIns *bool `protobuf:"varint,13,opt,name=allow_write_ins,json=allowWriteIns" json:"allow_write_ins,omitempty"`
+	// voters in the electorate nominate candidates until nominations_end,
+	// then voting opens with qualified nominees added to choices of the
+	// first question, which may start empty
+	NominationsEnd *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=nominations_end,json=nominationsEnd" json:"nominations_end,omitempty"`
+	// seconds by voters in the electorate other than the nominator and the
+	// nominee, needed to qualify
+	SecondsRequired *int32 `protobuf:"varint,15,opt,name=seconds_required,json=secondsRequired" json:"seconds_required,omitempty"`
+	// nominees must accept to qualify, they may decline regardless
+	AcceptanceRequired *bool `protobuf:"varint,16,opt,name=acceptance_required,json=acceptanceRequired" json:"acceptance_required,omitempty"`
+	// registered voters who may contribute to the random beacon breaking
+	// ties until end_date
+	Trustees []string `protobuf:"bytes,17,rep,name=trustees" json:"trustees,omitempty"`
+	// registered voters who may countersign the result document
+	Officers []string `protobuf:"bytes,18,rep,name=officers" json:"officers,omitempty"`
 }
 
-func (x *Key) Reset() {
-	*x = Key{}
+func (x *Election) Reset() {
+	*x = Election{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_voting_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -831,13 +1304,13 @@ func (x *Key) Reset() {
 	}
 }
 
-func (x *Key) String() string {
+func (x *Election) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Key) ProtoMessage() {}
+func (*Election) ProtoMessage() {}
 
-func (x *Key) ProtoReflect() protoreflect.Message {
+func (x *Election) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_voting_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -849,9 +1322,2989 @@ func (x *Key) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
+// Deprecated: Use Election.ProtoReflect.Descriptor instead.
+func (*Election) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Election) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Election) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *Election) GetChoices() []string {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *Election) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *Election) GetToken() *AuthToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *Election) GetRoll() []*RollEntry {
+	if x != nil {
+		return x.Roll
+	}
+	return nil
+}
+
+func (x *Election) GetGroupWeights() []*GroupWeight {
+	if x != nil {
+		return x.GroupWeights
+	}
+	return nil
+}
+
+func (x *Election) GetAllowRevote() bool {
+	if x != nil && x.AllowRevote != nil {
+		return *x.AllowRevote
+	}
+	return false
+}
+
+func (x *Election) GetQuorum() int32 {
+	if x != nil && x.Quorum != nil {
+		return *x.Quorum
+	}
+	return 0
+}
+
+func (x *Election) GetThreshold() Threshold {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
+	}
+	return Threshold_THRESHOLD_PLURALITY
+}
+
+func (x *Election) GetQuestions() []*Question {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *Election) GetRunoff() *durationpb.Duration {
+	if x != nil {
+		return x.Runoff
+	}
+	return nil
+}
+
+func (x *Election) GetAllowWriteIns() bool {
+	if x != nil && x.AllowWriteIns != nil {
+		return *x.AllowWriteIns
+	}
+	return false
+}
+
+func (x *Election) GetNominationsEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NominationsEnd
+	}
+	return nil
+}
+
+func (x *Election) GetSecondsRequired() int32 {
+	if x != nil && x.SecondsRequired != nil {
+		return *x.SecondsRequired
+	}
+	return 0
+}
+
+func (x *Election) GetAcceptanceRequired() bool {
+	if x != nil && x.AcceptanceRequired != nil {
+		return *x.AcceptanceRequired
+	}
+	return false
+}
+
+func (x *Election) GetTrustees() []string {
+	if x != nil {
+		return x.Trustees
+	}
+	return nil
+}
+
+func (x *Election) GetOfficers() []string {
+	if x != nil {
+		return x.Officers
+	}
+	return nil
+}
+
+type Question struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title     *string     `protobuf:"bytes,1,opt,name=title" json:"title,omitempty"`
+	Choices   []string    `protobuf:"bytes,2,rep,name=choices" json:"choices,omitempty"`
+	Type      *BallotType `protobuf:"varint,3,opt,name=type,enum=voting.BallotType" json:"type,omitempty"`
+	Threshold *Threshold  `protobuf:"varint,4,opt,name=threshold,enum=voting.Threshold" json:"threshold,omitempty"`
+	// choices not in choices are counted as write-ins, instead of rejected
+	AllowWriteIns *bool `protobuf:"varint,5,opt,name=allow_write_ins,json=allowWriteIns" json:"allow_write_ins,omitempty"`
+	// each voter distributes, for BALLOT_CUMULATIVE, or voice credits each
+	// voter has, for BALLOT_QUADRATIC
+	Points *int32 `protobuf:"varint,6,opt,name=points" json:"points,omitempty"`
+	// of choices, what approved ones on a ballot may cost in total, and how
+	// choices are funded, for BALLOT_BUDGET
+	Costs   []int64  `protobuf:"varint,7,rep,name=costs" json:"costs,omitempty"`
+	Budget  *int64   `protobuf:"varint,8,opt,name=budget" json:"budget,omitempty"`
+	Funding *Funding `protobuf:"varint,9,opt,name=funding,enum=voting.Funding" json:"funding,omitempty"`
+}
+
+func (x *Question) Reset() {
+	*x = Question{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Question) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Question) ProtoMessage() {}
+
+func (x *Question) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Question.ProtoReflect.Descriptor instead.
+func (*Question) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Question) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *Question) GetChoices() []string {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *Question) GetType() BallotType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return BallotType_BALLOT_SINGLE
+}
+
+func (x *Question) GetThreshold() Threshold {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
+	}
+	return Threshold_THRESHOLD_PLURALITY
+}
+
+func (x *Question) GetAllowWriteIns() bool {
+	if x != nil && x.AllowWriteIns != nil {
+		return *x.AllowWriteIns
+	}
+	return false
+}
+
+func (x *Question) GetPoints() int32 {
+	if x != nil && x.Points != nil {
+		return *x.Points
+	}
+	return 0
+}
+
+func (x *Question) GetCosts() []int64 {
+	if x != nil {
+		return x.Costs
+	}
+	return nil
+}
+
+func (x *Question) GetBudget() int64 {
+	if x != nil && x.Budget != nil {
+		return *x.Budget
+	}
+	return 0
+}
+
+func (x *Question) GetFunding() Funding {
+	if x != nil && x.Funding != nil {
+		return *x.Funding
+	}
+	return Funding_FUNDING_GREEDY
+}
+
+// GroupWeight overrides weights of voters in group or its subgroups in an
+// election. The most specific one matching a voter counts.
+type GroupWeight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group  *string `protobuf:"bytes,1,req,name=group" json:"group,omitempty"`
+	Weight *int64  `protobuf:"varint,2,req,name=weight" json:"weight,omitempty"`
+}
+
+func (x *GroupWeight) Reset() {
+	*x = GroupWeight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupWeight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupWeight) ProtoMessage() {}
+
+func (x *GroupWeight) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupWeight.ProtoReflect.Descriptor instead.
+func (*GroupWeight) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GroupWeight) GetGroup() string {
+	if x != nil && x.Group != nil {
+		return *x.Group
+	}
+	return ""
+}
+
+func (x *GroupWeight) GetWeight() int64 {
+	if x != nil && x.Weight != nil {
+		return *x.Weight
+	}
+	return 0
+}
+
+// A voter explicitly included in, or excluded from an election. Exclusion
+// wins over groups, inclusion works regardless of groups.
+type RollEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Exclude *bool   `protobuf:"varint,2,opt,name=exclude" json:"exclude,omitempty"`
+}
+
+func (x *RollEntry) Reset() {
+	*x = RollEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollEntry) ProtoMessage() {}
+
+func (x *RollEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollEntry.ProtoReflect.Descriptor instead.
+func (*RollEntry) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RollEntry) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *RollEntry) GetExclude() bool {
+	if x != nil && x.Exclude != nil {
+		return *x.Exclude
+	}
+	return false
+}
+
+// Only for the creator of the election. Changes the electorate only if
+// snapshot is set, or on the next SnapshotElectorate.
+type UpdateRollRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ElectionName *string      `protobuf:"bytes,1,req,name=election_name,json=electionName" json:"election_name,omitempty"`
+	Add          []*RollEntry `protobuf:"bytes,2,rep,name=add" json:"add,omitempty"`
+	Remove       []string     `protobuf:"bytes,3,rep,name=remove" json:"remove,omitempty"`
+	Token        *AuthToken   `protobuf:"bytes,4,req,name=token" json:"token,omitempty"`
+	Snapshot     *bool        `protobuf:"varint,5,opt,name=snapshot" json:"snapshot,omitempty"`
+}
+
+func (x *UpdateRollRequest) Reset() {
+	*x = UpdateRollRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRollRequest) ProtoMessage() {}
+
+func (x *UpdateRollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRollRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRollRequest) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateRollRequest) GetElectionName() string {
+	if x != nil && x.ElectionName != nil {
+		return *x.ElectionName
+	}
+	return ""
+}
+
+func (x *UpdateRollRequest) GetAdd() []*RollEntry {
+	if x != nil {
+		return x.Add
+	}
+	return nil
+}
+
+func (x *UpdateRollRequest) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+func (x *UpdateRollRequest) GetToken() *AuthToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *UpdateRollRequest) GetSnapshot() bool {
+	if x != nil && x.Snapshot != nil {
+		return *x.Snapshot
+	}
+	return false
+}
+
+// Where a delegation applies, either election_name, or all elections
+// allowing group or its subgroups. One for an election takes precedence,
+// then one for the most specific group.
+type DelegationScope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token        *AuthToken `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
+	ElectionName *string    `protobuf:"bytes,2,opt,name=election_name,json=electionName" json:"election_name,omitempty"`
+	Group        *string    `protobuf:"bytes,3,opt,name=group" json:"group,omitempty"`
+}
+
+func (x *DelegationScope) Reset() {
+	*x = DelegationScope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelegationScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegationScope) ProtoMessage() {}
+
+func (x *DelegationScope) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegationScope.ProtoReflect.Descriptor instead.
+func (*DelegationScope) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DelegationScope) GetToken() *AuthToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *DelegationScope) GetElectionName() string {
+	if x != nil && x.ElectionName != nil {
+		return *x.ElectionName
+	}
+	return ""
+}
+
+func (x *DelegationScope) GetGroup() string {
+	if x != nil && x.Group != nil {
+		return *x.Group
+	}
+	return ""
+}
+
+// Delegations are transitive. Those in force when an election ends count,
+// for those who did not vote themselves.
+type DelegateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope    *DelegationScope `protobuf:"bytes,1,req,name=scope" json:"scope,omitempty"`
+	Delegate *string          `protobuf:"bytes,2,req,name=delegate" json:"delegate,omitempty"`
+}
+
+func (x *DelegateRequest) Reset() {
+	*x = DelegateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelegateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegateRequest) ProtoMessage() {}
+
+func (x *DelegateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegateRequest.ProtoReflect.Descriptor instead.
+func (*DelegateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DelegateRequest) GetScope() *DelegationScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *DelegateRequest) GetDelegate() string {
+	if x != nil && x.Delegate != nil {
+		return *x.Delegate
+	}
+	return ""
+}
+
+// An election, for RPCs only for certain voters of it.
+type ElectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ElectionName *string    `protobuf:"bytes,1,req,name=election_name,json=electionName" json:"election_name,omitempty"`
+	Token        *AuthToken `protobuf:"bytes,2,req,name=token" json:"token,omitempty"`
+}
+
+func (x *ElectionRequest) Reset() {
+	*x = ElectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ElectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElectionRequest) ProtoMessage() {}
+
+func (x *ElectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElectionRequest.ProtoReflect.Descriptor instead.
+func (*ElectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ElectionRequest) GetElectionName() string {
+	if x != nil && x.ElectionName != nil {
+		return *x.ElectionName
+	}
+	return ""
+}
+
+func (x *ElectionRequest) GetToken() *AuthToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type Vote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ElectionName *string `protobuf:"bytes,1,req,name=election_name,json=electionName" json:"election_name,omitempty"`
+	// answers the first question, exclusive with answers
+	ChoiceName *string    `protobuf:"bytes,2,opt,name=choice_name,json=choiceName" json:"choice_name,omitempty"`
+	Token      *AuthToken `protobuf:"bytes,3,req,name=token" json:"token,omitempty"`
+	// cast a blank ballot, choice_name is ignored
+	Abstain *bool `protobuf:"varint,4,opt,name=abstain" json:"abstain,omitempty"`
+	// questions not answered are skipped
+	Answers []*Answer `protobuf:"bytes,5,rep,name=answers" json:"answers,omitempty"`
+}
+
+func (x *Vote) Reset() {
+	*x = Vote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vote) ProtoMessage() {}
+
+func (x *Vote) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vote.ProtoReflect.Descriptor instead.
+func (*Vote) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Vote) GetElectionName() string {
+	if x != nil && x.ElectionName != nil {
+		return *x.ElectionName
+	}
+	return ""
+}
+
+func (x *Vote) GetChoiceName() string {
+	if x != nil && x.ChoiceName != nil {
+		return *x.ChoiceName
+	}
+	return ""
+}
+
+func (x *Vote) GetToken() *AuthToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *Vote) GetAbstain() bool {
+	if x != nil && x.Abstain != nil {
+		return *x.Abstain
+	}
+	return false
+}
+
+func (x *Vote) GetAnswers() []*Answer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type Answer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index in questions of the election
+	Question *int32 `protobuf:"varint,1,req,name=question" json:"question,omitempty"`
+	// exactly one for BALLOT_SINGLE, at least one otherwise, write-ins
+	// included, in order of preference for BALLOT_BORDA
+	Choices []string `protobuf:"bytes,2,rep,name=choices" json:"choices,omitempty"`
+	// choices are ignored
+	Abstain *bool `protobuf:"varint,3,opt,name=abstain" json:"abstain,omitempty"`
+	// given to choices, for BALLOT_CUMULATIVE, or votes for BALLOT_QUADRATIC
+	Points []int32 `protobuf:"varint,4,rep,name=points" json:"points,omitempty"`
+}
+
+func (x *Answer) Reset() {
+	*x = Answer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Answer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Answer) ProtoMessage() {}
+
+func (x *Answer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Answer.ProtoReflect.Descriptor instead.
+func (*Answer) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Answer) GetQuestion() int32 {
+	if x != nil && x.Question != nil {
+		return *x.Question
+	}
+	return 0
+}
+
+func (x *Answer) GetChoices() []string {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *Answer) GetAbstain() bool {
+	if x != nil && x.Abstain != nil {
+		return *x.Abstain
+	}
+	return false
+}
+
+func (x *Answer) GetPoints() []int32 {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type ElectionName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+}
+
+func (x *ElectionName) Reset() {
+	*x = ElectionName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ElectionName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElectionName) ProtoMessage() {}
+
+func (x *ElectionName) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElectionName.ProtoReflect.Descriptor instead.
+func (*ElectionName) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ElectionName) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type VoteCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChoiceName *string `protobuf:"bytes,1,req,name=choice_name,json=choiceName" json:"choice_name,omitempty"`
+	Count      *int32  `protobuf:"varint,2,req,name=count" json:"count,omitempty"`
+	// by weights of voters
+	WeightedCount *int64 `protobuf:"varint,3,opt,name=weighted_count,json=weightedCount" json:"weighted_count,omitempty"`
+	// of the above, cast by proxy
+	ProxyCount         *int32 `protobuf:"varint,4,opt,name=proxy_count,json=proxyCount" json:"proxy_count,omitempty"`
+	ProxyWeightedCount *int64 `protobuf:"varint,5,opt,name=proxy_weighted_count,json=proxyWeightedCount" json:"proxy_weighted_count,omitempty"`
+	// write-ins as cast, counted for choice_name by adjudication
+	Merged []string `protobuf:"bytes,6,rep,name=merged" json:"merged,omitempty"`
+	// by weights of voters, for point-based ballot types, net votes for
+	// BALLOT_QUADRATIC
+	Points *int64 `protobuf:"varint,7,opt,name=points" json:"points,omitempty"`
+	// voice credits spent, by weights of voters, for BALLOT_QUADRATIC
+	Credits *int64 `protobuf:"varint,8,opt,name=credits" json:"credits,omitempty"`
+}
+
+func (x *VoteCount) Reset() {
+	*x = VoteCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoteCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteCount) ProtoMessage() {}
+
+func (x *VoteCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteCount.ProtoReflect.Descriptor instead.
+func (*VoteCount) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *VoteCount) GetChoiceName() string {
+	if x != nil && x.ChoiceName != nil {
+		return *x.ChoiceName
+	}
+	return ""
+}
+
+func (x *VoteCount) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *VoteCount) GetWeightedCount() int64 {
+	if x != nil && x.WeightedCount != nil {
+		return *x.WeightedCount
+	}
+	return 0
+}
+
+func (x *VoteCount) GetProxyCount() int32 {
+	if x != nil && x.ProxyCount != nil {
+		return *x.ProxyCount
+	}
+	return 0
+}
+
+func (x *VoteCount) GetProxyWeightedCount() int64 {
+	if x != nil && x.ProxyWeightedCount != nil {
+		return *x.ProxyWeightedCount
+	}
+	return 0
+}
+
+func (x *VoteCount) GetMerged() []string {
+	if x != nil {
+		return x.Merged
+	}
+	return nil
+}
+
+func (x *VoteCount) GetPoints() int64 {
+	if x != nil && x.Points != nil {
+		return *x.Points
+	}
+	return 0
+}
+
+func (x *VoteCount) GetCredits() int64 {
+	if x != nil && x.Credits != nil {
+		return *x.Credits
+	}
+	return 0
+}
+
+// Counts, write-ins, abstentions, winner, passed and funded are of the first
+// question.
+type ElectionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     *int32       `protobuf:"varint,1,req,name=status" json:"status,omitempty"`
+	Counts     []*VoteCount `protobuf:"bytes,2,rep,name=counts" json:"counts,omitempty"`
+	Electorate *int32       `protobuf:"varint,3,opt,name=electorate" json:"electorate,omitempty"`
+	// number of ballots cast
+	Turnout *int32 `protobuf:"varint,4,opt,name=turnout" json:"turnout,omitempty"`
+	// total weights of the electorate and of the ballots cast
+	ElectorateWeight *int64 `protobuf:"varint,5,opt,name=electorate_weight,json=electorateWeight" json:"electorate_weight,omitempty"`
+	TurnoutWeight    *int64 `protobuf:"varint,6,opt,name=turnout_weight,json=turnoutWeight" json:"turnout_weight,omitempty"`
+	// votes cast by proxy, not in turnout, and their total weight
+	Proxies     *int32 `protobuf:"varint,7,opt,name=proxies" json:"proxies,omitempty"`
+	ProxyWeight *int64 `protobuf:"varint,8,opt,name=proxy_weight,json=proxyWeight" json:"proxy_weight,omitempty"`
+	// blank ballots, by proxy or not, not in counts
+	Abstentions      *int32 `protobuf:"varint,9,opt,name=abstentions" json:"abstentions,omitempty"`
+	AbstentionWeight *int64 `protobuf:"varint,10,opt,name=abstention_weight,json=abstentionWeight" json:"abstention_weight,omitempty"`
+	QuorumMet        *bool  `protobuf:"varint,11,opt,name=quorum_met,json=quorumMet" json:"quorum_met,omitempty"`
+	// the leading choice, unset on ties not broken for lack of a beacon
+	Winner *string `protobuf:"bytes,12,opt,name=winner" json:"winner,omitempty"`
+	// quorum met and winner reached the threshold
+	Passed    *bool             `protobuf:"varint,13,opt,name=passed" json:"passed,omitempty"`
+	Questions []*QuestionResult `protobuf:"bytes,14,rep,name=questions" json:"questions,omitempty"`
+	// name of the runoff election, once created
+	Runoff   *string      `protobuf:"bytes,15,opt,name=runoff" json:"runoff,omitempty"`
+	WriteIns []*VoteCount `protobuf:"bytes,16,rep,name=write_ins,json=writeIns" json:"write_ins,omitempty"`
+	Funded   []string     `protobuf:"bytes,17,rep,name=funded" json:"funded,omitempty"`
+	// the random beacon breaking ties is SHA-256 of seed followed by values
+	// of contributions in order, each after its length as 4 bytes
+	// big-endian, commitment being SHA-256 of seed, all unset for elections
+	// from before
+	Seed       []byte `protobuf:"bytes,18,opt,name=seed" json:"seed,omitempty"`
+	Commitment []byte `protobuf:"bytes,19,opt,name=commitment" json:"commitment,omitempty"`
+	// by trustees, in order of name
+	Contributions []*TrusteeContribution `protobuf:"bytes,20,rep,name=contributions" json:"contributions,omitempty"`
+	Beacon        []byte                 `protobuf:"bytes,21,opt,name=beacon" json:"beacon,omitempty"`
+	// canonical result document as JSON, signed by the server with
+	// server_key, and countersigned by officers of the election with their
+	// voter keys, both detached Ed25519 signatures of it as is. Its
+	// "definition" is SHA-256 of the JSON object, in this key order, of
+	// "name", "creator", "start_date", "end_date" (RFC 3339, UTC),
+	// "groups", "roll" (of {"name", "exclude"}), "group_weights" (of
+	// {"group", "weight"}), "allow_revote", "quorum", "runoff_seconds",
+	// "first_round", "nominations_end" (null if none),
+	// "seconds_required", "acceptance_required", "questions" (of
+	// {"title", "type", "threshold", "choices", "allow_write_ins",
+	// "points", "costs", "budget", "funding"}, type, threshold and funding
+	// as enum names here), "commitment" (base64, null if none), and
+	// "trustees" and "officers" (names), as when voting ends. The
+	// electorate snapshot, seed and contributions of the beacon,
+	// countersignatures and the runoff created are not covered.
+	Certificate []byte `protobuf:"bytes,22,opt,name=certificate" json:"certificate,omitempty"`
+	Signature   []byte `protobuf:"bytes,23,opt,name=signature" json:"signature,omitempty"`
+	ServerKey   []byte `protobuf:"bytes,24,opt,name=server_key,json=serverKey" json:"server_key,omitempty"`
+	// only those of the document as is, e.g. not before adjudication
+	Countersignatures []*Countersignature `protobuf:"bytes,25,rep,name=countersignatures" json:"countersignatures,omitempty"`
+}
+
+func (x *ElectionResult) Reset() {
+	*x = ElectionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ElectionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElectionResult) ProtoMessage() {}
+
+func (x *ElectionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElectionResult.ProtoReflect.Descriptor instead.
+func (*ElectionResult) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ElectionResult) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *ElectionResult) GetCounts() []*VoteCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *ElectionResult) GetElectorate() int32 {
+	if x != nil && x.Electorate != nil {
+		return *x.Electorate
+	}
+	return 0
+}
+
+func (x *ElectionResult) GetTurnout() int32 {
+	if x != nil && x.Turnout != nil {
+		return *x.Turnout
+	}
+	return 0
+}
+
+func (x *ElectionResult) GetElectorateWeight() int64 {
+	if x != nil && x.ElectorateWeight != nil {
+		return *x.ElectorateWeight
+	}
+	return 0
+}
+
+func (x *ElectionResult) GetTurnoutWeight() int64 {
+	if x != nil && x.TurnoutWeight != nil {
+		return *x.TurnoutWeight
+	}
+	return 0
+}
+
+func (x *ElectionResult) GetProxies() int32 {
+	if x != nil && x.Proxies != nil {
+		return *x.Proxies
+	}
+	return 0
+}
+
+func (x *ElectionResult) GetProxyWeight() int64 {
+	if x != nil && x.ProxyWeight != nil {
+		return *x.ProxyWeight
+	}
+	return 0
+}
+
+func (x *ElectionResult) GetAbstentions() int32 {
+	if x != nil && x.Abstentions != nil {
+		return *x.Abstentions
+	}
+	return 0
+}
+
+func (x *ElectionResult) GetAbstentionWeight() int64 {
+	if x != nil && x.AbstentionWeight != nil {
+		return *x.AbstentionWeight
+	}
+	return 0
+}
+
+func (x *ElectionResult) GetQuorumMet() bool {
+	if x != nil && x.QuorumMet != nil {
+		return *x.QuorumMet
+	}
+	return false
+}
+
+func (x *ElectionResult) GetWinner() string {
+	if x != nil && x.Winner != nil {
+		return *x.Winner
+	}
+	return ""
+}
+
+func (x *ElectionResult) GetPassed() bool {
+	if x != nil && x.Passed != nil {
+		return *x.Passed
+	}
+	return false
+}
+
+func (x *ElectionResult) GetQuestions() []*QuestionResult {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *ElectionResult) GetRunoff() string {
+	if x != nil && x.Runoff != nil {
+		return *x.Runoff
+	}
+	return ""
+}
+
+func (x *ElectionResult) GetWriteIns() []*VoteCount {
+	if x != nil {
+		return x.WriteIns
+	}
+	return nil
+}
+
+func (x *ElectionResult) GetFunded() []string {
+	if x != nil {
+		return x.Funded
+	}
+	return nil
+}
+
+func (x *ElectionResult) GetSeed() []byte {
+	if x != nil {
+		return x.Seed
+	}
+	return nil
+}
+
+func (x *ElectionResult) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *ElectionResult) GetContributions() []*TrusteeContribution {
+	if x != nil {
+		return x.Contributions
+	}
+	return nil
+}
+
+func (x *ElectionResult) GetBeacon() []byte {
+	if x != nil {
+		return x.Beacon
+	}
+	return nil
+}
+
+func (x *ElectionResult) GetCertificate() []byte {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *ElectionResult) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *ElectionResult) GetServerKey() []byte {
+	if x != nil {
+		return x.ServerKey
+	}
+	return nil
+}
+
+func (x *ElectionResult) GetCountersignatures() []*Countersignature {
+	if x != nil {
+		return x.Countersignatures
+	}
+	return nil
+}
+
+type Countersignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Officer   *string `protobuf:"bytes,1,req,name=officer" json:"officer,omitempty"`
+	Signature []byte  `protobuf:"bytes,2,req,name=signature" json:"signature,omitempty"`
+}
+
+func (x *Countersignature) Reset() {
+	*x = Countersignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Countersignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Countersignature) ProtoMessage() {}
+
+func (x *Countersignature) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Countersignature.ProtoReflect.Descriptor instead.
+func (*Countersignature) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Countersignature) GetOfficer() string {
+	if x != nil && x.Officer != nil {
+		return *x.Officer
+	}
+	return ""
+}
+
+func (x *Countersignature) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type TrusteeContribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trustee *string `protobuf:"bytes,1,req,name=trustee" json:"trustee,omitempty"`
+	Value   []byte  `protobuf:"bytes,2,req,name=value" json:"value,omitempty"`
+}
+
+func (x *TrusteeContribution) Reset() {
+	*x = TrusteeContribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrusteeContribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrusteeContribution) ProtoMessage() {}
+
+func (x *TrusteeContribution) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrusteeContribution.ProtoReflect.Descriptor instead.
+func (*TrusteeContribution) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TrusteeContribution) GetTrustee() string {
+	if x != nil && x.Trustee != nil {
+		return *x.Trustee
+	}
+	return ""
+}
+
+func (x *TrusteeContribution) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type QuestionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title  *string      `protobuf:"bytes,1,opt,name=title" json:"title,omitempty"`
+	Counts []*VoteCount `protobuf:"bytes,2,rep,name=counts" json:"counts,omitempty"`
+	// blank answers, by proxy or not, not in counts
+	Abstentions      *int32 `protobuf:"varint,3,opt,name=abstentions" json:"abstentions,omitempty"`
+	AbstentionWeight *int64 `protobuf:"varint,4,opt,name=abstention_weight,json=abstentionWeight" json:"abstention_weight,omitempty"`
+	// the leading choice, unset on ties not broken for lack of a beacon
+	Winner *string `protobuf:"bytes,5,opt,name=winner" json:"winner,omitempty"`
+	// quorum met and winner reached the threshold
+	Passed *bool `protobuf:"varint,6,opt,name=passed" json:"passed,omitempty"`
+	// as adjudicated, not in counts
+	WriteIns []*VoteCount `protobuf:"bytes,7,rep,name=write_ins,json=writeIns" json:"write_ins,omitempty"`
+	// choices funded, in order of selection, for BALLOT_BUDGET
+	Funded []string `protobuf:"bytes,8,rep,name=funded" json:"funded,omitempty"`
+	// choices and write-ins in order of SHA-256 of the beacon, the index of
+	// the question as 4 bytes big-endian and the choice, earlier ones
+	// winning ties, also of funding
+	TieBreakOrder []string `protobuf:"bytes,9,rep,name=tie_break_order,json=tieBreakOrder" json:"tie_break_order,omitempty"`
+	// winner tied with others for the lead
+	TieBroken *bool `protobuf:"varint,10,opt,name=tie_broken,json=tieBroken" json:"tie_broken,omitempty"`
+}
+
+func (x *QuestionResult) Reset() {
+	*x = QuestionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuestionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionResult) ProtoMessage() {}
+
+func (x *QuestionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionResult.ProtoReflect.Descriptor instead.
+func (*QuestionResult) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *QuestionResult) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *QuestionResult) GetCounts() []*VoteCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+func (x *QuestionResult) GetAbstentions() int32 {
+	if x != nil && x.Abstentions != nil {
+		return *x.Abstentions
+	}
+	return 0
+}
+
+func (x *QuestionResult) GetAbstentionWeight() int64 {
+	if x != nil && x.AbstentionWeight != nil {
+		return *x.AbstentionWeight
+	}
+	return 0
+}
+
+func (x *QuestionResult) GetWinner() string {
+	if x != nil && x.Winner != nil {
+		return *x.Winner
+	}
+	return ""
+}
+
+func (x *QuestionResult) GetPassed() bool {
+	if x != nil && x.Passed != nil {
+		return *x.Passed
+	}
+	return false
+}
+
+func (x *QuestionResult) GetWriteIns() []*VoteCount {
+	if x != nil {
+		return x.WriteIns
+	}
+	return nil
+}
+
+func (x *QuestionResult) GetFunded() []string {
+	if x != nil {
+		return x.Funded
+	}
+	return nil
+}
+
+func (x *QuestionResult) GetTieBreakOrder() []string {
+	if x != nil {
+		return x.TieBreakOrder
+	}
+	return nil
+}
+
+func (x *QuestionResult) GetTieBroken() bool {
+	if x != nil && x.TieBroken != nil {
+		return *x.TieBroken
+	}
+	return false
+}
+
+type ListElectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// needed for eligible and mine
+	Token     *AuthToken     `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	PageSize  *int32         `protobuf:"varint,2,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	PageToken *string        `protobuf:"bytes,3,opt,name=page_token,json=pageToken" json:"page_token,omitempty"`
+	State     *ElectionState `protobuf:"varint,4,opt,name=state,enum=voting.ElectionState" json:"state,omitempty"`
+	// only elections the caller may vote in
+	Eligible *bool `protobuf:"varint,5,opt,name=eligible" json:"eligible,omitempty"`
+	// only elections created by the caller
+	Mine *bool `protobuf:"varint,6,opt,name=mine" json:"mine,omitempty"`
+}
+
+func (x *ListElectionsRequest) Reset() {
+	*x = ListElectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListElectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListElectionsRequest) ProtoMessage() {}
+
+func (x *ListElectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListElectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListElectionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListElectionsRequest) GetToken() *AuthToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *ListElectionsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListElectionsRequest) GetPageToken() string {
+	if x != nil && x.PageToken != nil {
+		return *x.PageToken
+	}
+	return ""
+}
+
+func (x *ListElectionsRequest) GetState() ElectionState {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return ElectionState_ELECTION_STATE_ANY
+}
+
+func (x *ListElectionsRequest) GetEligible() bool {
+	if x != nil && x.Eligible != nil {
+		return *x.Eligible
+	}
+	return false
+}
+
+func (x *ListElectionsRequest) GetMine() bool {
+	if x != nil && x.Mine != nil {
+		return *x.Mine
+	}
+	return false
+}
+
+type ElectionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      *string                `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Groups    []string               `protobuf:"bytes,2,rep,name=groups" json:"groups,omitempty"`
+	Choices   []string               `protobuf:"bytes,3,rep,name=choices" json:"choices,omitempty"`
+	StartDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate" json:"start_date,omitempty"`
+	EndDate   *timestamppb.Timestamp `protobuf:"bytes,5,req,name=end_date,json=endDate" json:"end_date,omitempty"`
+	State     *ElectionState         `protobuf:"varint,6,req,name=state,enum=voting.ElectionState" json:"state,omitempty"`
+	Creator   *string                `protobuf:"bytes,7,opt,name=creator" json:"creator,omitempty"`
+	// size of the electorate frozen at snapshot_date
+	Electorate       *int32                 `protobuf:"varint,8,opt,name=electorate" json:"electorate,omitempty"`
+	SnapshotDate     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=snapshot_date,json=snapshotDate" json:"snapshot_date,omitempty"`
+	GroupWeights     []*GroupWeight         `protobuf:"bytes,10,rep,name=group_weights,json=groupWeights" json:"group_weights,omitempty"`
+	ElectorateWeight *int64                 `protobuf:"varint,11,opt,name=electorate_weight,json=electorateWeight" json:"electorate_weight,omitempty"`
+	AllowRevote      *bool                  `protobuf:"varint,12,opt,name=allow_revote,json=allowRevote" json:"allow_revote,omitempty"`
+	Quorum           *int32                 `protobuf:"varint,13,opt,name=quorum" json:"quorum,omitempty"`
+	// choices, threshold and allow_write_ins are of the first question
+	Threshold *Threshold           `protobuf:"varint,14,opt,name=threshold,enum=voting.Threshold" json:"threshold,omitempty"`
+	Questions []*Question          `protobuf:"bytes,15,rep,name=questions" json:"questions,omitempty"`
+	Runoff    *durationpb.Duration `protobuf:"bytes,16,opt,name=runoff" json:"runoff,omitempty"`
+	// names of the runoff election once created, and of the election this
+	// is the runoff of
+	RunoffElection     *string                `protobuf:"bytes,17,opt,name=runoff_election,json=runoffElection" json:"runoff_election,omitempty"`
+	FirstRound         *string                `protobuf:"bytes,18,opt,name=first_round,json=firstRound" json:"first_round,omitempty"`
+	AllowWriteIns      *bool                  `protobuf:"varint,19,opt,name=allow_write_ins,json=allowWriteIns" json:"allow_write_ins,omitempty"`
+	NominationsEnd     *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=nominations_end,json=nominationsEnd" json:"nominations_end,omitempty"`
+	SecondsRequired    *int32                 `protobuf:"varint,21,opt,name=seconds_required,json=secondsRequired" json:"seconds_required,omitempty"`
+	AcceptanceRequired *bool                  `protobuf:"varint,22,opt,name=acceptance_required,json=acceptanceRequired" json:"acceptance_required,omitempty"`
+	// SHA-256 of the seed of the random beacon breaking ties, unset for
+	// elections from before
+	Commitment []byte   `protobuf:"bytes,23,opt,name=commitment" json:"commitment,omitempty"`
+	Trustees   []string `protobuf:"bytes,24,rep,name=trustees" json:"trustees,omitempty"`
+	Officers   []string `protobuf:"bytes,25,rep,name=officers" json:"officers,omitempty"`
+}
+
+func (x *ElectionInfo) Reset() {
+	*x = ElectionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ElectionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElectionInfo) ProtoMessage() {}
+
+func (x *ElectionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElectionInfo.ProtoReflect.Descriptor instead.
+func (*ElectionInfo) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ElectionInfo) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *ElectionInfo) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *ElectionInfo) GetChoices() []string {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *ElectionInfo) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ElectionInfo) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ElectionInfo) GetState() ElectionState {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return ElectionState_ELECTION_STATE_ANY
+}
+
+func (x *ElectionInfo) GetCreator() string {
+	if x != nil && x.Creator != nil {
+		return *x.Creator
+	}
+	return ""
+}
+
+func (x *ElectionInfo) GetElectorate() int32 {
+	if x != nil && x.Electorate != nil {
+		return *x.Electorate
+	}
+	return 0
+}
+
+func (x *ElectionInfo) GetSnapshotDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SnapshotDate
+	}
+	return nil
+}
+
+func (x *ElectionInfo) GetGroupWeights() []*GroupWeight {
+	if x != nil {
+		return x.GroupWeights
+	}
+	return nil
+}
+
+func (x *ElectionInfo) GetElectorateWeight() int64 {
+	if x != nil && x.ElectorateWeight != nil {
+		return *x.ElectorateWeight
+	}
+	return 0
+}
+
+func (x *ElectionInfo) GetAllowRevote() bool {
+	if x != nil && x.AllowRevote != nil {
+		return *x.AllowRevote
+	}
+	return false
+}
+
+func (x *ElectionInfo) GetQuorum() int32 {
+	if x != nil && x.Quorum != nil {
+		return *x.Quorum
+	}
+	return 0
+}
+
+func (x *ElectionInfo) GetThreshold() Threshold {
+	if x != nil && x.Threshold != nil {
+		return *x.Threshold
+	}
+	return Threshold_THRESHOLD_PLURALITY
+}
+
+func (x *ElectionInfo) GetQuestions() []*Question {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *ElectionInfo) GetRunoff() *durationpb.Duration {
+	if x != nil {
+		return x.Runoff
+	}
+	return nil
+}
+
+func (x *ElectionInfo) GetRunoffElection() string {
+	if x != nil && x.RunoffElection != nil {
+		return *x.RunoffElection
+	}
+	return ""
+}
+
+func (x *ElectionInfo) GetFirstRound() string {
+	if x != nil && x.FirstRound != nil {
+		return *x.FirstRound
+	}
+	return ""
+}
+
+func (x *ElectionInfo) GetAllowWriteIns() bool {
+	if x != nil && x.AllowWriteIns != nil {
+		return *x.AllowWriteIns
+	}
+	return false
+}
+
+func (x *ElectionInfo) GetNominationsEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NominationsEnd
+	}
+	return nil
+}
+
+func (x *ElectionInfo) GetSecondsRequired() int32 {
+	if x != nil && x.SecondsRequired != nil {
+		return *x.SecondsRequired
+	}
+	return 0
+}
+
+func (x *ElectionInfo) GetAcceptanceRequired() bool {
+	if x != nil && x.AcceptanceRequired != nil {
+		return *x.AcceptanceRequired
+	}
+	return false
+}
+
+func (x *ElectionInfo) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *ElectionInfo) GetTrustees() []string {
+	if x != nil {
+		return x.Trustees
+	}
+	return nil
+}
+
+func (x *ElectionInfo) GetOfficers() []string {
+	if x != nil {
+		return x.Officers
+	}
+	return nil
+}
+
+// Only for the creator of the election, after it ends. Merges write-ins as
+// cast for the question at index question into the choice of each merge,
+// either a write-in or not. Merging one into itself undoes its merge.
+type WriteInAdjudication struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token        *AuthToken      `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
+	ElectionName *string         `protobuf:"bytes,2,req,name=election_name,json=electionName" json:"election_name,omitempty"`
+	Question     *int32          `protobuf:"varint,3,opt,name=question" json:"question,omitempty"`
+	Merges       []*WriteInMerge `protobuf:"bytes,4,rep,name=merges" json:"merges,omitempty"`
+}
+
+func (x *WriteInAdjudication) Reset() {
+	*x = WriteInAdjudication{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteInAdjudication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteInAdjudication) ProtoMessage() {}
+
+func (x *WriteInAdjudication) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteInAdjudication.ProtoReflect.Descriptor instead.
+func (*WriteInAdjudication) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WriteInAdjudication) GetToken() *AuthToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *WriteInAdjudication) GetElectionName() string {
+	if x != nil && x.ElectionName != nil {
+		return *x.ElectionName
+	}
+	return ""
+}
+
+func (x *WriteInAdjudication) GetQuestion() int32 {
+	if x != nil && x.Question != nil {
+		return *x.Question
+	}
+	return 0
+}
+
+func (x *WriteInAdjudication) GetMerges() []*WriteInMerge {
+	if x != nil {
+		return x.Merges
+	}
+	return nil
+}
+
+type WriteInMerge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WriteIn *string `protobuf:"bytes,1,req,name=write_in,json=writeIn" json:"write_in,omitempty"`
+	Choice  *string `protobuf:"bytes,2,req,name=choice" json:"choice,omitempty"`
+}
+
+func (x *WriteInMerge) Reset() {
+	*x = WriteInMerge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteInMerge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteInMerge) ProtoMessage() {}
+
+func (x *WriteInMerge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteInMerge.ProtoReflect.Descriptor instead.
+func (*WriteInMerge) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WriteInMerge) GetWriteIn() string {
+	if x != nil && x.WriteIn != nil {
+		return *x.WriteIn
+	}
+	return ""
+}
+
+func (x *WriteInMerge) GetChoice() string {
+	if x != nil && x.Choice != nil {
+		return *x.Choice
+	}
+	return ""
+}
+
+// For Nominate by voters in the electorate, of a registered voter, and for
+// SecondNomination of a nominee, until nominations_end.
+type NominationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token        *AuthToken `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
+	ElectionName *string    `protobuf:"bytes,2,req,name=election_name,json=electionName" json:"election_name,omitempty"`
+	Nominee      *string    `protobuf:"bytes,3,req,name=nominee" json:"nominee,omitempty"`
+}
+
+func (x *NominationRequest) Reset() {
+	*x = NominationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NominationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NominationRequest) ProtoMessage() {}
+
+func (x *NominationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NominationRequest.ProtoReflect.Descriptor instead.
+func (*NominationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *NominationRequest) GetToken() *AuthToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *NominationRequest) GetElectionName() string {
+	if x != nil && x.ElectionName != nil {
+		return *x.ElectionName
+	}
+	return ""
+}
+
+func (x *NominationRequest) GetNominee() string {
+	if x != nil && x.Nominee != nil {
+		return *x.Nominee
+	}
+	return ""
+}
+
+// By a trustee of the election, once, until end_date.
+type BeaconContribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token        *AuthToken `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
+	ElectionName *string    `protobuf:"bytes,2,req,name=election_name,json=electionName" json:"election_name,omitempty"`
+	Value        []byte     `protobuf:"bytes,3,req,name=value" json:"value,omitempty"`
+}
+
+func (x *BeaconContribution) Reset() {
+	*x = BeaconContribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeaconContribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeaconContribution) ProtoMessage() {}
+
+func (x *BeaconContribution) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeaconContribution.ProtoReflect.Descriptor instead.
+func (*BeaconContribution) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BeaconContribution) GetToken() *AuthToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *BeaconContribution) GetElectionName() string {
+	if x != nil && x.ElectionName != nil {
+		return *x.ElectionName
+	}
+	return ""
+}
+
+func (x *BeaconContribution) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// By an officer of the election, of the certificate in its result.
+type ResultCertification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token        *AuthToken `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
+	ElectionName *string    `protobuf:"bytes,2,req,name=election_name,json=electionName" json:"election_name,omitempty"`
+	Signature    []byte     `protobuf:"bytes,3,req,name=signature" json:"signature,omitempty"`
+}
+
+func (x *ResultCertification) Reset() {
+	*x = ResultCertification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResultCertification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultCertification) ProtoMessage() {}
+
+func (x *ResultCertification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultCertification.ProtoReflect.Descriptor instead.
+func (*ResultCertification) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ResultCertification) GetToken() *AuthToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *ResultCertification) GetElectionName() string {
+	if x != nil && x.ElectionName != nil {
+		return *x.ElectionName
+	}
+	return ""
+}
+
+func (x *ResultCertification) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// Only for the creator and officers of the election, at any time.
+type TurnoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token        *AuthToken `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
+	ElectionName *string    `protobuf:"bytes,2,req,name=election_name,json=electionName" json:"election_name,omitempty"`
+	// of each step of the timeline, an hour if unset
+	Interval *durationpb.Duration `protobuf:"bytes,3,opt,name=interval" json:"interval,omitempty"`
+}
+
+func (x *TurnoutRequest) Reset() {
+	*x = TurnoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TurnoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TurnoutRequest) ProtoMessage() {}
+
+func (x *TurnoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TurnoutRequest.ProtoReflect.Descriptor instead.
+func (*TurnoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TurnoutRequest) GetToken() *AuthToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *TurnoutRequest) GetElectionName() string {
+	if x != nil && x.ElectionName != nil {
+		return *x.ElectionName
+	}
+	return ""
+}
+
+func (x *TurnoutRequest) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+// Ballots cast, by voters rather than by proxy, without what they answer.
+type TurnoutStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Electorate       *int32 `protobuf:"varint,1,opt,name=electorate" json:"electorate,omitempty"`
+	Turnout          *int32 `protobuf:"varint,2,opt,name=turnout" json:"turnout,omitempty"`
+	ElectorateWeight *int64 `protobuf:"varint,3,opt,name=electorate_weight,json=electorateWeight" json:"electorate_weight,omitempty"`
+	TurnoutWeight    *int64 `protobuf:"varint,4,opt,name=turnout_weight,json=turnoutWeight" json:"turnout_weight,omitempty"`
+	// for each group of the election, in order of group; voters included
+	// by the roll alone are in none
+	Groups []*GroupTurnout `protobuf:"bytes,5,rep,name=groups" json:"groups,omitempty"`
+	// turnout by the end of each interval since the start where any voter
+	// first cast a ballot, without ballots from before their time was
+	// recorded
+	Timeline []*TurnoutStep `protobuf:"bytes,6,rep,name=timeline" json:"timeline,omitempty"`
+}
+
+func (x *TurnoutStats) Reset() {
+	*x = TurnoutStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TurnoutStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TurnoutStats) ProtoMessage() {}
+
+func (x *TurnoutStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TurnoutStats.ProtoReflect.Descriptor instead.
+func (*TurnoutStats) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TurnoutStats) GetElectorate() int32 {
+	if x != nil && x.Electorate != nil {
+		return *x.Electorate
+	}
+	return 0
+}
+
+func (x *TurnoutStats) GetTurnout() int32 {
+	if x != nil && x.Turnout != nil {
+		return *x.Turnout
+	}
+	return 0
+}
+
+func (x *TurnoutStats) GetElectorateWeight() int64 {
+	if x != nil && x.ElectorateWeight != nil {
+		return *x.ElectorateWeight
+	}
+	return 0
+}
+
+func (x *TurnoutStats) GetTurnoutWeight() int64 {
+	if x != nil && x.TurnoutWeight != nil {
+		return *x.TurnoutWeight
+	}
+	return 0
+}
+
+func (x *TurnoutStats) GetGroups() []*GroupTurnout {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *TurnoutStats) GetTimeline() []*TurnoutStep {
+	if x != nil {
+		return x.Timeline
+	}
+	return nil
+}
+
+type GroupTurnout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group            *string `protobuf:"bytes,1,req,name=group" json:"group,omitempty"`
+	Electorate       *int32  `protobuf:"varint,2,opt,name=electorate" json:"electorate,omitempty"`
+	Turnout          *int32  `protobuf:"varint,3,opt,name=turnout" json:"turnout,omitempty"`
+	ElectorateWeight *int64  `protobuf:"varint,4,opt,name=electorate_weight,json=electorateWeight" json:"electorate_weight,omitempty"`
+	TurnoutWeight    *int64  `protobuf:"varint,5,opt,name=turnout_weight,json=turnoutWeight" json:"turnout_weight,omitempty"`
+}
+
+func (x *GroupTurnout) Reset() {
+	*x = GroupTurnout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupTurnout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupTurnout) ProtoMessage() {}
+
+func (x *GroupTurnout) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupTurnout.ProtoReflect.Descriptor instead.
+func (*GroupTurnout) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GroupTurnout) GetGroup() string {
+	if x != nil && x.Group != nil {
+		return *x.Group
+	}
+	return ""
+}
+
+func (x *GroupTurnout) GetElectorate() int32 {
+	if x != nil && x.Electorate != nil {
+		return *x.Electorate
+	}
+	return 0
+}
+
+func (x *GroupTurnout) GetTurnout() int32 {
+	if x != nil && x.Turnout != nil {
+		return *x.Turnout
+	}
+	return 0
+}
+
+func (x *GroupTurnout) GetElectorateWeight() int64 {
+	if x != nil && x.ElectorateWeight != nil {
+		return *x.ElectorateWeight
+	}
+	return 0
+}
+
+func (x *GroupTurnout) GetTurnoutWeight() int64 {
+	if x != nil && x.TurnoutWeight != nil {
+		return *x.TurnoutWeight
+	}
+	return 0
+}
+
+type TurnoutStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Until         *timestamppb.Timestamp `protobuf:"bytes,1,req,name=until" json:"until,omitempty"`
+	Turnout       *int32                 `protobuf:"varint,2,opt,name=turnout" json:"turnout,omitempty"`
+	TurnoutWeight *int64                 `protobuf:"varint,3,opt,name=turnout_weight,json=turnoutWeight" json:"turnout_weight,omitempty"`
+}
+
+func (x *TurnoutStep) Reset() {
+	*x = TurnoutStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TurnoutStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TurnoutStep) ProtoMessage() {}
+
+func (x *TurnoutStep) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TurnoutStep.ProtoReflect.Descriptor instead.
+func (*TurnoutStep) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TurnoutStep) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *TurnoutStep) GetTurnout() int32 {
+	if x != nil && x.Turnout != nil {
+		return *x.Turnout
+	}
+	return 0
+}
+
+func (x *TurnoutStep) GetTurnoutWeight() int64 {
+	if x != nil && x.TurnoutWeight != nil {
+		return *x.TurnoutWeight
+	}
+	return 0
+}
+
+// Streams events of the election as they happen, until cancelled. Turnout
+// events are only for the creator and officers of the election, as
+// GetTurnout.
+type WatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ElectionName *string `protobuf:"bytes,1,req,name=election_name,json=electionName" json:"election_name,omitempty"`
+	// resume after the event with this id, as after a failover, from the
+	// first event if unset
+	After *int64     `protobuf:"varint,2,opt,name=after" json:"after,omitempty"`
+	Token *AuthToken `protobuf:"bytes,3,req,name=token" json:"token,omitempty"`
+}
+
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRequest) ProtoMessage() {}
+
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *WatchRequest) GetElectionName() string {
+	if x != nil && x.ElectionName != nil {
+		return *x.ElectionName
+	}
+	return ""
+}
+
+func (x *WatchRequest) GetAfter() int64 {
+	if x != nil && x.After != nil {
+		return *x.After
+	}
+	return 0
+}
+
+func (x *WatchRequest) GetToken() *AuthToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type ElectionEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// increasing in the order events are recorded, the same on all nodes
+	Id      *int64                 `protobuf:"varint,1,req,name=id" json:"id,omitempty"`
+	Kind    *EventKind             `protobuf:"varint,2,req,name=kind,enum=voting.EventKind" json:"kind,omitempty"`
+	At      *timestamppb.Timestamp `protobuf:"bytes,3,req,name=at" json:"at,omitempty"`
+	Percent *int32                 `protobuf:"varint,4,opt,name=percent" json:"percent,omitempty"`
+	Officer *string                `protobuf:"bytes,5,opt,name=officer" json:"officer,omitempty"`
+}
+
+func (x *ElectionEvent) Reset() {
+	*x = ElectionEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ElectionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElectionEvent) ProtoMessage() {}
+
+func (x *ElectionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElectionEvent.ProtoReflect.Descriptor instead.
+func (*ElectionEvent) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ElectionEvent) GetId() int64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *ElectionEvent) GetKind() EventKind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return EventKind_EVENT_OPENED
+}
+
+func (x *ElectionEvent) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *ElectionEvent) GetPercent() int32 {
+	if x != nil && x.Percent != nil {
+		return *x.Percent
+	}
+	return 0
+}
+
+func (x *ElectionEvent) GetOfficer() string {
+	if x != nil && x.Officer != nil {
+		return *x.Officer
+	}
+	return ""
+}
+
+// The nominee accepting or declining their nomination.
+type NominationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token        *AuthToken `protobuf:"bytes,1,req,name=token" json:"token,omitempty"`
+	ElectionName *string    `protobuf:"bytes,2,req,name=election_name,json=electionName" json:"election_name,omitempty"`
+	Accept       *bool      `protobuf:"varint,3,req,name=accept" json:"accept,omitempty"`
+}
+
+func (x *NominationResponse) Reset() {
+	*x = NominationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NominationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NominationResponse) ProtoMessage() {}
+
+func (x *NominationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NominationResponse.ProtoReflect.Descriptor instead.
+func (*NominationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *NominationResponse) GetToken() *AuthToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *NominationResponse) GetElectionName() string {
+	if x != nil && x.ElectionName != nil {
+		return *x.ElectionName
+	}
+	return ""
+}
+
+func (x *NominationResponse) GetAccept() bool {
+	if x != nil && x.Accept != nil {
+		return *x.Accept
+	}
+	return false
+}
+
+type NominationInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nominee   *string `protobuf:"bytes,1,req,name=nominee" json:"nominee,omitempty"`
+	Nominator *string `protobuf:"bytes,2,opt,name=nominator" json:"nominator,omitempty"`
+	Seconds   *int32  `protobuf:"varint,3,opt,name=seconds" json:"seconds,omitempty"`
+	// unset until the nominee responds
+	Accepted  *bool `protobuf:"varint,4,opt,name=accepted" json:"accepted,omitempty"`
+	Qualified *bool `protobuf:"varint,5,opt,name=qualified" json:"qualified,omitempty"`
+}
+
+func (x *NominationInfo) Reset() {
+	*x = NominationInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NominationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NominationInfo) ProtoMessage() {}
+
+func (x *NominationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NominationInfo.ProtoReflect.Descriptor instead.
+func (*NominationInfo) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *NominationInfo) GetNominee() string {
+	if x != nil && x.Nominee != nil {
+		return *x.Nominee
+	}
+	return ""
+}
+
+func (x *NominationInfo) GetNominator() string {
+	if x != nil && x.Nominator != nil {
+		return *x.Nominator
+	}
+	return ""
+}
+
+func (x *NominationInfo) GetSeconds() int32 {
+	if x != nil && x.Seconds != nil {
+		return *x.Seconds
+	}
+	return 0
+}
+
+func (x *NominationInfo) GetAccepted() bool {
+	if x != nil && x.Accepted != nil {
+		return *x.Accepted
+	}
+	return false
+}
+
+func (x *NominationInfo) GetQualified() bool {
+	if x != nil && x.Qualified != nil {
+		return *x.Qualified
+	}
+	return false
+}
+
+type NominationList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nominations []*NominationInfo `protobuf:"bytes,1,rep,name=nominations" json:"nominations,omitempty"`
+}
+
+func (x *NominationList) Reset() {
+	*x = NominationList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NominationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NominationList) ProtoMessage() {}
+
+func (x *NominationList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NominationList.ProtoReflect.Descriptor instead.
+func (*NominationList) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *NominationList) GetNominations() []*NominationInfo {
+	if x != nil {
+		return x.Nominations
+	}
+	return nil
+}
+
+type ElectionList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Elections []*ElectionInfo `protobuf:"bytes,1,rep,name=elections" json:"elections,omitempty"`
+	// empty on the last page
+	NextPageToken *string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
+}
+
+func (x *ElectionList) Reset() {
+	*x = ElectionList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ElectionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElectionList) ProtoMessage() {}
+
+func (x *ElectionList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElectionList.ProtoReflect.Descriptor instead.
+func (*ElectionList) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ElectionList) GetElections() []*ElectionInfo {
+	if x != nil {
+		return x.Elections
+	}
+	return nil
+}
+
+func (x *ElectionList) GetNextPageToken() string {
+	if x != nil && x.NextPageToken != nil {
+		return *x.NextPageToken
+	}
+	return ""
+}
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{48}
+}
+
+type NodesList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Primary *NodeIdentifier   `protobuf:"bytes,1,req,name=primary" json:"primary,omitempty"`
+	Nodes   []*NodeIdentifier `protobuf:"bytes,2,rep,name=nodes" json:"nodes,omitempty"`
+}
+
+func (x *NodesList) Reset() {
+	*x = NodesList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodesList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodesList) ProtoMessage() {}
+
+func (x *NodesList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodesList.ProtoReflect.Descriptor instead.
+func (*NodesList) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *NodesList) GetPrimary() *NodeIdentifier {
+	if x != nil {
+		return x.Primary
+	}
+	return nil
+}
+
+func (x *NodesList) GetNodes() []*NodeIdentifier {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type NodeIdentifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address *string `protobuf:"bytes,1,req,name=address" json:"address,omitempty"`
+	// of the database of the node, when joining; the primary turns away
+	// nodes of another version
+	SchemaVersion *int32 `protobuf:"varint,2,opt,name=schema_version,json=schemaVersion" json:"schema_version,omitempty"`
+}
+
+func (x *NodeIdentifier) Reset() {
+	*x = NodeIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeIdentifier) ProtoMessage() {}
+
+func (x *NodeIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeIdentifier.ProtoReflect.Descriptor instead.
+func (*NodeIdentifier) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *NodeIdentifier) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *NodeIdentifier) GetSchemaVersion() int32 {
+	if x != nil && x.SchemaVersion != nil {
+		return *x.SchemaVersion
+	}
+	return 0
+}
+
+type Key struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Key  []byte  `protobuf:"bytes,2,req,name=key" json:"key,omitempty"`
+}
+
+func (x *Key) Reset() {
+	*x = Key{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Key) ProtoMessage() {}
+
+func (x *Key) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
 // Deprecated: Use Key.ProtoReflect.Descriptor instead.
 func (*Key) Descriptor() ([]byte, []int) {
-	return file_proto_voting_proto_rawDescGZIP(), []int{15}
+	return file_proto_voting_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *Key) GetName() string {
@@ -868,6 +4321,53 @@ func (x *Key) GetKey() []byte {
 	return nil
 }
 
+type KeyList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []*Key `protobuf:"bytes,1,rep,name=keys" json:"keys,omitempty"`
+}
+
+func (x *KeyList) Reset() {
+	*x = KeyList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_voting_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyList) ProtoMessage() {}
+
+func (x *KeyList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_voting_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyList.ProtoReflect.Descriptor instead.
+func (*KeyList) Descriptor() ([]byte, []int) {
+	return file_proto_voting_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *KeyList) GetKeys() []*Key {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
 type Dump struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -877,12 +4377,14 @@ type Dump struct {
 	Keys       []*Key  `protobuf:"bytes,2,rep,name=keys" json:"keys,omitempty"`
 	ServerPub  []byte  `protobuf:"bytes,3,req,name=serverPub" json:"serverPub,omitempty"`
 	ServerPriv []byte  `protobuf:"bytes,4,req,name=serverPriv" json:"serverPriv,omitempty"`
+	// of the database dumped, backups of another version can't restore it
+	SchemaVersion *int32 `protobuf:"varint,5,opt,name=schema_version,json=schemaVersion" json:"schema_version,omitempty"`
 }
 
 func (x *Dump) Reset() {
 	*x = Dump{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_voting_proto_msgTypes[16]
+		mi := &file_proto_voting_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -895,7 +4397,7 @@ func (x *Dump) String() string {
 func (*Dump) ProtoMessage() {}
 
 func (x *Dump) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_voting_proto_msgTypes[16]
+	mi := &file_proto_voting_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -908,7 +4410,7 @@ func (x *Dump) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Dump.ProtoReflect.Descriptor instead.
 func (*Dump) Descriptor() ([]byte, []int) {
-	return file_proto_voting_proto_rawDescGZIP(), []int{16}
+	return file_proto_voting_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *Dump) GetContent() string {
@@ -939,6 +4441,13 @@ func (x *Dump) GetServerPriv() []byte {
 	return nil
 }
 
+func (x *Dump) GetSchemaVersion() int32 {
+	if x != nil && x.SchemaVersion != nil {
+		return *x.SchemaVersion
+	}
+	return 0
+}
+
 type SqlRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -950,7 +4459,7 @@ type SqlRequest struct {
 func (x *SqlRequest) Reset() {
 	*x = SqlRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_voting_proto_msgTypes[17]
+		mi := &file_proto_voting_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -963,7 +4472,7 @@ func (x *SqlRequest) String() string {
 func (*SqlRequest) ProtoMessage() {}
 
 func (x *SqlRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_voting_proto_msgTypes[17]
+	mi := &file_proto_voting_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -976,7 +4485,7 @@ func (x *SqlRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SqlRequest.ProtoReflect.Descriptor instead.
 func (*SqlRequest) Descriptor() ([]byte, []int) {
-	return file_proto_voting_proto_rawDescGZIP(), []int{17}
+	return file_proto_voting_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *SqlRequest) GetCommand() string {
@@ -990,125 +4499,714 @@ var File_proto_voting_proto protoreflect.FileDescriptor
 
 var file_proto_voting_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x1a, 0x1f, 0x67, 0x6f,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x50, 0x0a,
-	0x05, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
-	0x20, 0x02, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22,
-	0x1f, 0x0a, 0x09, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x22, 0x1c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x21,
-	0x0a, 0x09, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x20, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x01,
+	0x0a, 0x05, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x02, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x36, 0x0a, 0x0a, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x02, 0x28,
+	0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x55, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x52,
+	0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22,
+	0x62, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x22, 0x4e, 0x0a, 0x0a, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x70, 0x0a, 0x0b, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x6e, 0x0a, 0x09, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x65, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6f, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x5e, 0x0a, 0x09,
+	0x56, 0x6f, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x76, 0x6f, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x76, 0x6f,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x1f, 0x0a, 0x09,
+	0x56, 0x6f, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x38, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72,
+	0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x22, 0x21, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
+	0x02, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x20, 0x0a, 0x08, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x02, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x62, 0x0a, 0x0b,
+	0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02,
+	0x20, 0x02, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x21, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a,
 	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x62, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x6f,
-	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
-	0x02, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x08, 0x45,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x35, 0x0a,
-	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x02, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20,
-	0x02, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x75, 0x74,
-	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x75, 0x0a,
-	0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68,
-	0x6f, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52,
-	0x0a, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74,
-	0x69, 0x6e, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x22, 0x0a, 0x0c, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x42, 0x0a, 0x09, 0x56, 0x6f, 0x74, 0x65,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x6f, 0x69,
-	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x02, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x53, 0x0a, 0x0e,
-	0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x6c, 0x75, 0x65, 0x22, 0xe1, 0x05, 0x0a, 0x08, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76,
+	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x6c, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x6f,
+	0x6c, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x6c, 0x12, 0x38, 0x0a,
+	0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x5f, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75,
+	0x6f, 0x72, 0x75, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x72,
+	0x75, 0x6d, 0x12, 0x2f, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x2e, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x6f, 0x66, 0x66, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x72, 0x75, 0x6e, 0x6f, 0x66, 0x66, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x12, 0x43,
+	0x0a, 0x0f, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x65, 0x6e,
+	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x45, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x2f,
+	0x0a, 0x13, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
+	0x66, 0x66, 0x69, 0x63, 0x65, 0x72, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f,
+	0x66, 0x66, 0x69, 0x63, 0x65, 0x72, 0x73, 0x22, 0xac, 0x02, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x6f,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x61, 0x6c, 0x6c,
+	0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x26, 0x0a,
+	0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x49, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x73, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x66,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x76,
+	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x66,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x3b, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x02, 0x28, 0x03, 0x52, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x39, 0x0a, 0x09, 0x52, 0x6f, 0x6c, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x22, 0xba,
+	0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x03, 0x61, 0x64, 0x64,
 	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e,
-	0x56, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x6b, 0x0a, 0x09, 0x4e, 0x6f,
-	0x64, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61,
-	0x72, 0x79, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e,
-	0x67, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x52, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x6e, 0x6f, 0x64,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e,
-	0x67, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x0e, 0x4e, 0x6f, 0x64, 0x65, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x2b, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x22, 0x7f, 0x0a, 0x04, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0b, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b,
-	0x65, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62,
-	0x18, 0x03, 0x20, 0x02, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75,
-	0x62, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x72, 0x69, 0x76, 0x18,
-	0x04, 0x20, 0x02, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x72, 0x69,
-	0x76, 0x22, 0x26, 0x0a, 0x0a, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x32, 0x74, 0x0a, 0x0c, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0d, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x0d, 0x2e, 0x76, 0x6f, 0x74,
-	0x69, 0x6e, 0x67, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x1a, 0x0e, 0x2e, 0x76, 0x6f, 0x74, 0x69,
-	0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x0f, 0x55, 0x6e, 0x72,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x11, 0x2e, 0x76,
-	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x1a,
-	0x0e, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32,
-	0x83, 0x02, 0x0a, 0x07, 0x65, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a, 0x07, 0x50,
-	0x72, 0x65, 0x41, 0x75, 0x74, 0x68, 0x12, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e,
-	0x56, 0x6f, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69,
-	0x6e, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x04,
-	0x41, 0x75, 0x74, 0x68, 0x12, 0x13, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x75,
-	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69,
-	0x6e, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x32, 0x0a, 0x0e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10,
+	0x52, 0x6f, 0x6c, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x61, 0x64, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x04, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x75, 0x0a, 0x0f, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x27,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x22, 0x5c, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x02, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x22, 0x5f, 0x0a, 0x0f, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0xb9, 0x01, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x27, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x02, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x73,
+	0x74, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x62, 0x73, 0x74,
+	0x61, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0x70, 0x0a,
+	0x06, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22,
+	0x22, 0x0a, 0x0c, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x86, 0x02, 0x0a, 0x09, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x02, 0x28,
+	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x30, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x22, 0x88, 0x07, 0x0a,
+	0x0e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x61,
+	0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x11,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x61, 0x74, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x75, 0x72,
+	0x6e, 0x6f, 0x75, 0x74, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x62, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x61, 0x62, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x62, 0x73, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x5f, 0x6d, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x4d, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x09, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x6f, 0x66, 0x66, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x75, 0x6e, 0x6f, 0x66, 0x66, 0x12, 0x2e, 0x0a, 0x09, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76,
+	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x08, 0x77, 0x72, 0x69, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x75, 0x6e,
+	0x64, 0x65, 0x64, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x75, 0x6e, 0x64, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76,
+	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12,
+	0x46, 0x0a, 0x11, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x6f, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x11, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x4a, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x66, 0x66, 0x69, 0x63, 0x65, 0x72, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x66,
+	0x66, 0x69, 0x63, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0x45, 0x0a, 0x13, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x65, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x02, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xdf, 0x02, 0x0a, 0x0e, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x6f, 0x74,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x62, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x62, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x2b, 0x0a, 0x11, 0x61, 0x62, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x62, 0x73,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x2e, 0x0a,
+	0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x08, 0x77, 0x72, 0x69, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x69, 0x65, 0x5f, 0x62, 0x72, 0x65,
+	0x61, 0x6b, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x74, 0x69, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x69, 0x65, 0x5f, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x74, 0x69, 0x65, 0x42, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd8, 0x01, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x76, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6c, 0x69, 0x67, 0x69,
+	0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6c, 0x69, 0x67, 0x69,
+	0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x65, 0x22, 0x8f, 0x08, 0x0a, 0x0c, 0x45, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x39,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x02, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x61, 0x74, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x76, 0x6f,
+	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x2f, 0x0a, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2e, 0x0a, 0x09, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x72,
+	0x75, 0x6e, 0x6f, 0x66, 0x66, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x75, 0x6e, 0x6f, 0x66, 0x66, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x75, 0x6e, 0x6f, 0x66, 0x66, 0x5f, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x75, 0x6e, 0x6f, 0x66, 0x66, 0x45,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x6e, 0x73,
+	0x12, 0x43, 0x0a, 0x0f, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f,
+	0x65, 0x6e, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x45, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x12, 0x2f, 0x0a, 0x13, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x65, 0x73, 0x18, 0x18, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x72, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x65, 0x72, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x13, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x49, 0x6e, 0x41, 0x64, 0x6a, 0x75, 0x64, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x06, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x6e, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x52, 0x06, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x0c, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x49, 0x6e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x5f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x07, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x02, 0x28, 0x09, 0x52, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x7b, 0x0a, 0x11,
+	0x4e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x65, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x09,
+	0x52, 0x07, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x65, 0x65, 0x22, 0x78, 0x0a, 0x12, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x27, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x02, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x0e, 0x54, 0x75, 0x72, 0x6e,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22,
+	0xfb, 0x01, 0x0a, 0x0c, 0x54, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x61, 0x74,
+	0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x75, 0x72, 0x6e, 0x6f,
+	0x75, 0x74, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2c,
+	0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x75, 0x72,
+	0x6e, 0x6f, 0x75, 0x74, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2f, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x53,
+	0x74, 0x65, 0x70, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xb2, 0x01,
+	0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x12, 0x2b,
+	0x0a, 0x11, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x61, 0x74, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x0b, 0x54, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x53, 0x74,
+	0x65, 0x70, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x02, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x72, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x12, 0x27, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x02, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa6, 0x01, 0x0a, 0x0d, 0x45, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x02, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x03, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x61, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x69,
+	0x63, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x69, 0x63,
+	0x65, 0x72, 0x22, 0x7a, 0x0a, 0x12, 0x4e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x02, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x18, 0x03, 0x20, 0x02, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0x9c,
+	0x01, 0x0a, 0x0e, 0x4e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x65, 0x65, 0x18, 0x01, 0x20, 0x02,
+	0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0x4a, 0x0a,
+	0x0e, 0x4e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x38, 0x0a, 0x0b, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x6f,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x6e, 0x6f,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6a, 0x0a, 0x0c, 0x45, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76,
+	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x09, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x6b,
+	0x0a, 0x09, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x70,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x02, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76,
+	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2c, 0x0a,
+	0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76,
+	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x0e, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2b,
+	0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x02, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x02, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x2a, 0x0a, 0x07, 0x4b,
+	0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4b, 0x65,
+	0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x04, 0x44, 0x75, 0x6d, 0x70,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x02, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x18, 0x03, 0x20, 0x02, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x50, 0x72, 0x69, 0x76, 0x18, 0x04, 0x20, 0x02, 0x28, 0x0c, 0x52, 0x0a, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x72, 0x69, 0x76, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x26, 0x0a, 0x0a, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x2a, 0x86, 0x01, 0x0a, 0x0a, 0x42, 0x61, 0x6c,
+	0x6c, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x41, 0x4c, 0x4c, 0x4f,
+	0x54, 0x5f, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x42, 0x41,
+	0x4c, 0x4c, 0x4f, 0x54, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x10, 0x01, 0x12,
+	0x15, 0x0a, 0x11, 0x42, 0x41, 0x4c, 0x4c, 0x4f, 0x54, 0x5f, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41,
+	0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x41, 0x4c, 0x4c, 0x4f, 0x54,
+	0x5f, 0x42, 0x4f, 0x52, 0x44, 0x41, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x41, 0x4c, 0x4c,
+	0x4f, 0x54, 0x5f, 0x51, 0x55, 0x41, 0x44, 0x52, 0x41, 0x54, 0x49, 0x43, 0x10, 0x04, 0x12, 0x11,
+	0x0a, 0x0d, 0x42, 0x41, 0x4c, 0x4c, 0x4f, 0x54, 0x5f, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x10,
+	0x05, 0x2a, 0x37, 0x0a, 0x07, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x0e,
+	0x46, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x47, 0x52, 0x45, 0x45, 0x44, 0x59, 0x10, 0x00,
+	0x12, 0x18, 0x0a, 0x14, 0x46, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x51, 0x55, 0x41,
+	0x4c, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x53, 0x10, 0x01, 0x2a, 0x7e, 0x0a, 0x09, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x48, 0x52, 0x45, 0x53,
+	0x48, 0x4f, 0x4c, 0x44, 0x5f, 0x50, 0x4c, 0x55, 0x52, 0x41, 0x4c, 0x49, 0x54, 0x59, 0x10, 0x00,
+	0x12, 0x1d, 0x0a, 0x19, 0x54, 0x48, 0x52, 0x45, 0x53, 0x48, 0x4f, 0x4c, 0x44, 0x5f, 0x53, 0x49,
+	0x4d, 0x50, 0x4c, 0x45, 0x5f, 0x4d, 0x41, 0x4a, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x10, 0x01, 0x12,
+	0x18, 0x0a, 0x14, 0x54, 0x48, 0x52, 0x45, 0x53, 0x48, 0x4f, 0x4c, 0x44, 0x5f, 0x54, 0x57, 0x4f,
+	0x5f, 0x54, 0x48, 0x49, 0x52, 0x44, 0x53, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x48, 0x52,
+	0x45, 0x53, 0x48, 0x4f, 0x4c, 0x44, 0x5f, 0x41, 0x42, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x45, 0x5f,
+	0x4d, 0x41, 0x4a, 0x4f, 0x52, 0x49, 0x54, 0x59, 0x10, 0x03, 0x2a, 0x80, 0x01, 0x0a, 0x0d, 0x45,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x12,
+	0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41,
+	0x4e, 0x59, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x4c, 0x45, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13,
+	0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x4d, 0x49, 0x4e, 0x41, 0x54,
+	0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x7e, 0x0a,
+	0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x55, 0x52, 0x4e, 0x4f, 0x55, 0x54, 0x10, 0x01, 0x12,
+	0x10, 0x0a, 0x0c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c,
+	0x54, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x45, 0x52,
+	0x54, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x32, 0xd4, 0x03,
+	0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e,
+	0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x12,
+	0x0d, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x1a, 0x0e,
+	0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34,
+	0x0a, 0x0f, 0x55, 0x6e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65,
+	0x72, 0x12, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x0e, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x12, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x1a, 0x0e, 0x2e, 0x76, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x10, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x12, 0x2e,
+	0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x1a, 0x0e, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x32, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x72,
+	0x12, 0x13, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x0e, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x65,
+	0x72, 0x12, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x6f,
+	0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x56,
+	0x6f, 0x74, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56,
+	0x6f, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x28, 0x01, 0x32, 0xa4, 0x09, 0x0a, 0x07, 0x65, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x2f, 0x0a, 0x07, 0x50, 0x72, 0x65, 0x41, 0x75, 0x74, 0x68, 0x12, 0x11, 0x2e, 0x76, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x11,
+	0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x12, 0x2e, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68, 0x12, 0x13, 0x2e, 0x76, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11,
+	0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x32, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x10, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0e, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x08, 0x43, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74,
+	0x65, 0x12, 0x0c, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x1a,
+	0x0e, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x39, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x2e, 0x76,
+	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x1a, 0x16, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x76, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x39, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
 	0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x1a, 0x0e, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x28, 0x0a, 0x08, 0x43, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x0c, 0x2e, 0x76,
-	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x1a, 0x0e, 0x2e, 0x76, 0x6f, 0x74,
-	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
-	0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x16, 0x2e,
-	0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0xdc, 0x01, 0x0a, 0x04, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x2c,
-	0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x16, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e,
-	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x1a, 0x0c,
-	0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x30, 0x0a, 0x0c,
-	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x11, 0x2e, 0x76,
-	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x1a,
-	0x0d, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x28,
-	0x0a, 0x03, 0x53, 0x71, 0x6c, 0x12, 0x12, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53,
-	0x71, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x76, 0x6f, 0x74, 0x69,
-	0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x06, 0x4e, 0x65, 0x77, 0x4b,
-	0x65, 0x79, 0x12, 0x0b, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4b, 0x65, 0x79, 0x1a,
+	0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x14, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x37, 0x0a, 0x0a, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x12, 0x19, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x12, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x45,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x61, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x76, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x17,
+	0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x2e, 0x76, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x1a, 0x0e, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x41, 0x0a, 0x12, 0x41, 0x64, 0x6a, 0x75, 0x64, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x76, 0x6f, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x49, 0x6e, 0x41, 0x64, 0x6a, 0x75, 0x64,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0e, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x4e, 0x6f, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x6f, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d,
+	0x0a, 0x10, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x4e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x6f, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e,
+	0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3f, 0x0a,
+	0x11, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x6f, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x0e,
+	0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3f,
+	0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x14, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x16, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x4e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x40, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x6f, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0x0e, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x1b, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x0e, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x3a, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x54, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x2e,
+	0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54,
+	0x75, 0x72, 0x6e, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x76,
+	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x32, 0x87, 0x02, 0x0a, 0x04,
+	0x53, 0x79, 0x6e, 0x63, 0x12, 0x2c, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x16, 0x2e, 0x76,
+	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x1a, 0x0c, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x75,
+	0x6d, 0x70, 0x12, 0x30, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x12, 0x11, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x03, 0x53, 0x71, 0x6c, 0x12, 0x12, 0x2e, 0x76, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x71, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x0d, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x24,
-	0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x0d, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0d, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x78, 0x64, 0x61, 0x76, 0x69, 0x64, 0x77, 0x75, 0x2f, 0x65, 0x76, 0x6f, 0x74,
-	0x69, 0x6e, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x0a, 0x06, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x12, 0x0b, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x4b, 0x65, 0x79, 0x1a, 0x0d, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x07, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x73, 0x12,
+	0x0f, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x1a, 0x0d, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x24, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x0d, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0d, 0x2e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x78, 0x64, 0x61, 0x76, 0x69, 0x64, 0x77, 0x75, 0x2f, 0x65, 0x76, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 }
 
 var (
@@ -1123,67 +5221,207 @@ func file_proto_voting_proto_rawDescGZIP() []byte {
 	return file_proto_voting_proto_rawDescData
 }
 
-var file_proto_voting_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_proto_voting_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_proto_voting_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_proto_voting_proto_goTypes = []interface{}{
-	(*Voter)(nil),                 // 0: voting.Voter
-	(*VoterName)(nil),             // 1: voting.VoterName
-	(*Status)(nil),                // 2: voting.Status
-	(*Challenge)(nil),             // 3: voting.Challenge
-	(*Response)(nil),              // 4: voting.Response
-	(*AuthRequest)(nil),           // 5: voting.AuthRequest
-	(*AuthToken)(nil),             // 6: voting.AuthToken
-	(*Election)(nil),              // 7: voting.Election
-	(*Vote)(nil),                  // 8: voting.Vote
-	(*ElectionName)(nil),          // 9: voting.ElectionName
-	(*VoteCount)(nil),             // 10: voting.VoteCount
-	(*ElectionResult)(nil),        // 11: voting.ElectionResult
-	(*Empty)(nil),                 // 12: voting.Empty
-	(*NodesList)(nil),             // 13: voting.NodesList
-	(*NodeIdentifier)(nil),        // 14: voting.NodeIdentifier
-	(*Key)(nil),                   // 15: voting.Key
-	(*Dump)(nil),                  // 16: voting.Dump
-	(*SqlRequest)(nil),            // 17: voting.SqlRequest
-	(*timestamppb.Timestamp)(nil), // 18: google.protobuf.Timestamp
+	(BallotType)(0),               // 0: voting.BallotType
+	(Funding)(0),                  // 1: voting.Funding
+	(Threshold)(0),                // 2: voting.Threshold
+	(ElectionState)(0),            // 3: voting.ElectionState
+	(EventKind)(0),                // 4: voting.EventKind
+	(*Voter)(nil),                 // 5: voting.Voter
+	(*Membership)(nil),            // 6: voting.Membership
+	(*RegisterVotersRequest)(nil), // 7: voting.RegisterVotersRequest
+	(*RegisterVotersResult)(nil),  // 8: voting.RegisterVotersResult
+	(*VoterError)(nil),            // 9: voting.VoterError
+	(*VoterUpdate)(nil),           // 10: voting.VoterUpdate
+	(*VoterInfo)(nil),             // 11: voting.VoterInfo
+	(*ListVotersRequest)(nil),     // 12: voting.ListVotersRequest
+	(*VoterList)(nil),             // 13: voting.VoterList
+	(*VoterName)(nil),             // 14: voting.VoterName
+	(*Status)(nil),                // 15: voting.Status
+	(*Challenge)(nil),             // 16: voting.Challenge
+	(*Response)(nil),              // 17: voting.Response
+	(*AuthRequest)(nil),           // 18: voting.AuthRequest
+	(*AuthToken)(nil),             // 19: voting.AuthToken
+	(*Election)(nil),              // 20: voting.Election
+	(*Question)(nil),              // 21: voting.Question
+	(*GroupWeight)(nil),           // 22: voting.GroupWeight
+	(*RollEntry)(nil),             // 23: voting.RollEntry
+	(*UpdateRollRequest)(nil),     // 24: voting.UpdateRollRequest
+	(*DelegationScope)(nil),       // 25: voting.DelegationScope
+	(*DelegateRequest)(nil),       // 26: voting.DelegateRequest
+	(*ElectionRequest)(nil),       // 27: voting.ElectionRequest
+	(*Vote)(nil),                  // 28: voting.Vote
+	(*Answer)(nil),                // 29: voting.Answer
+	(*ElectionName)(nil),          // 30: voting.ElectionName
+	(*VoteCount)(nil),             // 31: voting.VoteCount
+	(*ElectionResult)(nil),        // 32: voting.ElectionResult
+	(*Countersignature)(nil),      // 33: voting.Countersignature
+	(*TrusteeContribution)(nil),   // 34: voting.TrusteeContribution
+	(*QuestionResult)(nil),        // 35: voting.QuestionResult
+	(*ListElectionsRequest)(nil),  // 36: voting.ListElectionsRequest
+	(*ElectionInfo)(nil),          // 37: voting.ElectionInfo
+	(*WriteInAdjudication)(nil),   // 38: voting.WriteInAdjudication
+	(*WriteInMerge)(nil),          // 39: voting.WriteInMerge
+	(*NominationRequest)(nil),     // 40: voting.NominationRequest
+	(*BeaconContribution)(nil),    // 41: voting.BeaconContribution
+	(*ResultCertification)(nil),   // 42: voting.ResultCertification
+	(*TurnoutRequest)(nil),        // 43: voting.TurnoutRequest
+	(*TurnoutStats)(nil),          // 44: voting.TurnoutStats
+	(*GroupTurnout)(nil),          // 45: voting.GroupTurnout
+	(*TurnoutStep)(nil),           // 46: voting.TurnoutStep
+	(*WatchRequest)(nil),          // 47: voting.WatchRequest
+	(*ElectionEvent)(nil),         // 48: voting.ElectionEvent
+	(*NominationResponse)(nil),    // 49: voting.NominationResponse
+	(*NominationInfo)(nil),        // 50: voting.NominationInfo
+	(*NominationList)(nil),        // 51: voting.NominationList
+	(*ElectionList)(nil),          // 52: voting.ElectionList
+	(*Empty)(nil),                 // 53: voting.Empty
+	(*NodesList)(nil),             // 54: voting.NodesList
+	(*NodeIdentifier)(nil),        // 55: voting.NodeIdentifier
+	(*Key)(nil),                   // 56: voting.Key
+	(*KeyList)(nil),               // 57: voting.KeyList
+	(*Dump)(nil),                  // 58: voting.Dump
+	(*SqlRequest)(nil),            // 59: voting.SqlRequest
+	(*timestamppb.Timestamp)(nil), // 60: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 61: google.protobuf.Duration
 }
 var file_proto_voting_proto_depIdxs = []int32{
-	1,  // 0: voting.AuthRequest.name:type_name -> voting.VoterName
-	4,  // 1: voting.AuthRequest.response:type_name -> voting.Response
-	18, // 2: voting.Election.end_date:type_name -> google.protobuf.Timestamp
-	6,  // 3: voting.Election.token:type_name -> voting.AuthToken
-	6,  // 4: voting.Vote.token:type_name -> voting.AuthToken
-	10, // 5: voting.ElectionResult.counts:type_name -> voting.VoteCount
-	14, // 6: voting.NodesList.primary:type_name -> voting.NodeIdentifier
-	14, // 7: voting.NodesList.nodes:type_name -> voting.NodeIdentifier
-	15, // 8: voting.Dump.keys:type_name -> voting.Key
-	0,  // 9: voting.Registration.RegisterVoter:input_type -> voting.Voter
-	1,  // 10: voting.Registration.UnregisterVoter:input_type -> voting.VoterName
-	1,  // 11: voting.eVoting.PreAuth:input_type -> voting.VoterName
-	5,  // 12: voting.eVoting.Auth:input_type -> voting.AuthRequest
-	7,  // 13: voting.eVoting.CreateElection:input_type -> voting.Election
-	8,  // 14: voting.eVoting.CastVote:input_type -> voting.Vote
-	9,  // 15: voting.eVoting.GetResult:input_type -> voting.ElectionName
-	14, // 16: voting.Sync.Join:input_type -> voting.NodeIdentifier
-	13, // 17: voting.Sync.NodesChanged:input_type -> voting.NodesList
-	17, // 18: voting.Sync.Sql:input_type -> voting.SqlRequest
-	15, // 19: voting.Sync.NewKey:input_type -> voting.Key
-	12, // 20: voting.Sync.Ping:input_type -> voting.Empty
-	2,  // 21: voting.Registration.RegisterVoter:output_type -> voting.Status
-	2,  // 22: voting.Registration.UnregisterVoter:output_type -> voting.Status
-	3,  // 23: voting.eVoting.PreAuth:output_type -> voting.Challenge
-	6,  // 24: voting.eVoting.Auth:output_type -> voting.AuthToken
-	2,  // 25: voting.eVoting.CreateElection:output_type -> voting.Status
-	2,  // 26: voting.eVoting.CastVote:output_type -> voting.Status
-	11, // 27: voting.eVoting.GetResult:output_type -> voting.ElectionResult
-	16, // 28: voting.Sync.Join:output_type -> voting.Dump
-	12, // 29: voting.Sync.NodesChanged:output_type -> voting.Empty
-	12, // 30: voting.Sync.Sql:output_type -> voting.Empty
-	12, // 31: voting.Sync.NewKey:output_type -> voting.Empty
-	12, // 32: voting.Sync.Ping:output_type -> voting.Empty
-	21, // [21:33] is the sub-list for method output_type
-	9,  // [9:21] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	5,  // 0: voting.RegisterVotersRequest.voter:type_name -> voting.Voter
+	9,  // 1: voting.RegisterVotersResult.errors:type_name -> voting.VoterError
+	11, // 2: voting.VoterList.voters:type_name -> voting.VoterInfo
+	14, // 3: voting.AuthRequest.name:type_name -> voting.VoterName
+	17, // 4: voting.AuthRequest.response:type_name -> voting.Response
+	60, // 5: voting.Election.end_date:type_name -> google.protobuf.Timestamp
+	19, // 6: voting.Election.token:type_name -> voting.AuthToken
+	23, // 7: voting.Election.roll:type_name -> voting.RollEntry
+	22, // 8: voting.Election.group_weights:type_name -> voting.GroupWeight
+	2,  // 9: voting.Election.threshold:type_name -> voting.Threshold
+	21, // 10: voting.Election.questions:type_name -> voting.Question
+	61, // 11: voting.Election.runoff:type_name -> google.protobuf.Duration
+	60, // 12: voting.Election.nominations_end:type_name -> google.protobuf.Timestamp
+	0,  // 13: voting.Question.type:type_name -> voting.BallotType
+	2,  // 14: voting.Question.threshold:type_name -> voting.Threshold
+	1,  // 15: voting.Question.funding:type_name -> voting.Funding
+	23, // 16: voting.UpdateRollRequest.add:type_name -> voting.RollEntry
+	19, // 17: voting.UpdateRollRequest.token:type_name -> voting.AuthToken
+	19, // 18: voting.DelegationScope.token:type_name -> voting.AuthToken
+	25, // 19: voting.DelegateRequest.scope:type_name -> voting.DelegationScope
+	19, // 20: voting.ElectionRequest.token:type_name -> voting.AuthToken
+	19, // 21: voting.Vote.token:type_name -> voting.AuthToken
+	29, // 22: voting.Vote.answers:type_name -> voting.Answer
+	31, // 23: voting.ElectionResult.counts:type_name -> voting.VoteCount
+	35, // 24: voting.ElectionResult.questions:type_name -> voting.QuestionResult
+	31, // 25: voting.ElectionResult.write_ins:type_name -> voting.VoteCount
+	34, // 26: voting.ElectionResult.contributions:type_name -> voting.TrusteeContribution
+	33, // 27: voting.ElectionResult.countersignatures:type_name -> voting.Countersignature
+	31, // 28: voting.QuestionResult.counts:type_name -> voting.VoteCount
+	31, // 29: voting.QuestionResult.write_ins:type_name -> voting.VoteCount
+	19, // 30: voting.ListElectionsRequest.token:type_name -> voting.AuthToken
+	3,  // 31: voting.ListElectionsRequest.state:type_name -> voting.ElectionState
+	60, // 32: voting.ElectionInfo.start_date:type_name -> google.protobuf.Timestamp
+	60, // 33: voting.ElectionInfo.end_date:type_name -> google.protobuf.Timestamp
+	3,  // 34: voting.ElectionInfo.state:type_name -> voting.ElectionState
+	60, // 35: voting.ElectionInfo.snapshot_date:type_name -> google.protobuf.Timestamp
+	22, // 36: voting.ElectionInfo.group_weights:type_name -> voting.GroupWeight
+	2,  // 37: voting.ElectionInfo.threshold:type_name -> voting.Threshold
+	21, // 38: voting.ElectionInfo.questions:type_name -> voting.Question
+	61, // 39: voting.ElectionInfo.runoff:type_name -> google.protobuf.Duration
+	60, // 40: voting.ElectionInfo.nominations_end:type_name -> google.protobuf.Timestamp
+	19, // 41: voting.WriteInAdjudication.token:type_name -> voting.AuthToken
+	39, // 42: voting.WriteInAdjudication.merges:type_name -> voting.WriteInMerge
+	19, // 43: voting.NominationRequest.token:type_name -> voting.AuthToken
+	19, // 44: voting.BeaconContribution.token:type_name -> voting.AuthToken
+	19, // 45: voting.ResultCertification.token:type_name -> voting.AuthToken
+	19, // 46: voting.TurnoutRequest.token:type_name -> voting.AuthToken
+	61, // 47: voting.TurnoutRequest.interval:type_name -> google.protobuf.Duration
+	45, // 48: voting.TurnoutStats.groups:type_name -> voting.GroupTurnout
+	46, // 49: voting.TurnoutStats.timeline:type_name -> voting.TurnoutStep
+	60, // 50: voting.TurnoutStep.until:type_name -> google.protobuf.Timestamp
+	19, // 51: voting.WatchRequest.token:type_name -> voting.AuthToken
+	4,  // 52: voting.ElectionEvent.kind:type_name -> voting.EventKind
+	60, // 53: voting.ElectionEvent.at:type_name -> google.protobuf.Timestamp
+	19, // 54: voting.NominationResponse.token:type_name -> voting.AuthToken
+	50, // 55: voting.NominationList.nominations:type_name -> voting.NominationInfo
+	37, // 56: voting.ElectionList.elections:type_name -> voting.ElectionInfo
+	55, // 57: voting.NodesList.primary:type_name -> voting.NodeIdentifier
+	55, // 58: voting.NodesList.nodes:type_name -> voting.NodeIdentifier
+	56, // 59: voting.KeyList.keys:type_name -> voting.Key
+	56, // 60: voting.Dump.keys:type_name -> voting.Key
+	5,  // 61: voting.Registration.RegisterVoter:input_type -> voting.Voter
+	14, // 62: voting.Registration.UnregisterVoter:input_type -> voting.VoterName
+	6,  // 63: voting.Registration.AddMembership:input_type -> voting.Membership
+	6,  // 64: voting.Registration.RemoveMembership:input_type -> voting.Membership
+	10, // 65: voting.Registration.UpdateVoter:input_type -> voting.VoterUpdate
+	14, // 66: voting.Registration.GetVoter:input_type -> voting.VoterName
+	12, // 67: voting.Registration.ListVoters:input_type -> voting.ListVotersRequest
+	7,  // 68: voting.Registration.RegisterVoters:input_type -> voting.RegisterVotersRequest
+	14, // 69: voting.eVoting.PreAuth:input_type -> voting.VoterName
+	18, // 70: voting.eVoting.Auth:input_type -> voting.AuthRequest
+	20, // 71: voting.eVoting.CreateElection:input_type -> voting.Election
+	28, // 72: voting.eVoting.CastVote:input_type -> voting.Vote
+	30, // 73: voting.eVoting.GetResult:input_type -> voting.ElectionName
+	36, // 74: voting.eVoting.ListElections:input_type -> voting.ListElectionsRequest
+	30, // 75: voting.eVoting.GetElection:input_type -> voting.ElectionName
+	24, // 76: voting.eVoting.UpdateRoll:input_type -> voting.UpdateRollRequest
+	27, // 77: voting.eVoting.SnapshotElectorate:input_type -> voting.ElectionRequest
+	26, // 78: voting.eVoting.Delegate:input_type -> voting.DelegateRequest
+	25, // 79: voting.eVoting.RevokeDelegation:input_type -> voting.DelegationScope
+	38, // 80: voting.eVoting.AdjudicateWriteIns:input_type -> voting.WriteInAdjudication
+	40, // 81: voting.eVoting.Nominate:input_type -> voting.NominationRequest
+	40, // 82: voting.eVoting.SecondNomination:input_type -> voting.NominationRequest
+	49, // 83: voting.eVoting.RespondNomination:input_type -> voting.NominationResponse
+	30, // 84: voting.eVoting.ListNominations:input_type -> voting.ElectionName
+	41, // 85: voting.eVoting.ContributeToBeacon:input_type -> voting.BeaconContribution
+	42, // 86: voting.eVoting.CertifyResult:input_type -> voting.ResultCertification
+	43, // 87: voting.eVoting.GetTurnout:input_type -> voting.TurnoutRequest
+	47, // 88: voting.eVoting.WatchElection:input_type -> voting.WatchRequest
+	55, // 89: voting.Sync.Join:input_type -> voting.NodeIdentifier
+	54, // 90: voting.Sync.NodesChanged:input_type -> voting.NodesList
+	59, // 91: voting.Sync.Sql:input_type -> voting.SqlRequest
+	56, // 92: voting.Sync.NewKey:input_type -> voting.Key
+	57, // 93: voting.Sync.NewKeys:input_type -> voting.KeyList
+	53, // 94: voting.Sync.Ping:input_type -> voting.Empty
+	15, // 95: voting.Registration.RegisterVoter:output_type -> voting.Status
+	15, // 96: voting.Registration.UnregisterVoter:output_type -> voting.Status
+	15, // 97: voting.Registration.AddMembership:output_type -> voting.Status
+	15, // 98: voting.Registration.RemoveMembership:output_type -> voting.Status
+	15, // 99: voting.Registration.UpdateVoter:output_type -> voting.Status
+	11, // 100: voting.Registration.GetVoter:output_type -> voting.VoterInfo
+	13, // 101: voting.Registration.ListVoters:output_type -> voting.VoterList
+	8,  // 102: voting.Registration.RegisterVoters:output_type -> voting.RegisterVotersResult
+	16, // 103: voting.eVoting.PreAuth:output_type -> voting.Challenge
+	19, // 104: voting.eVoting.Auth:output_type -> voting.AuthToken
+	15, // 105: voting.eVoting.CreateElection:output_type -> voting.Status
+	15, // 106: voting.eVoting.CastVote:output_type -> voting.Status
+	32, // 107: voting.eVoting.GetResult:output_type -> voting.ElectionResult
+	52, // 108: voting.eVoting.ListElections:output_type -> voting.ElectionList
+	37, // 109: voting.eVoting.GetElection:output_type -> voting.ElectionInfo
+	15, // 110: voting.eVoting.UpdateRoll:output_type -> voting.Status
+	15, // 111: voting.eVoting.SnapshotElectorate:output_type -> voting.Status
+	15, // 112: voting.eVoting.Delegate:output_type -> voting.Status
+	15, // 113: voting.eVoting.RevokeDelegation:output_type -> voting.Status
+	15, // 114: voting.eVoting.AdjudicateWriteIns:output_type -> voting.Status
+	15, // 115: voting.eVoting.Nominate:output_type -> voting.Status
+	15, // 116: voting.eVoting.SecondNomination:output_type -> voting.Status
+	15, // 117: voting.eVoting.RespondNomination:output_type -> voting.Status
+	51, // 118: voting.eVoting.ListNominations:output_type -> voting.NominationList
+	15, // 119: voting.eVoting.ContributeToBeacon:output_type -> voting.Status
+	15, // 120: voting.eVoting.CertifyResult:output_type -> voting.Status
+	44, // 121: voting.eVoting.GetTurnout:output_type -> voting.TurnoutStats
+	48, // 122: voting.eVoting.WatchElection:output_type -> voting.ElectionEvent
+	58, // 123: voting.Sync.Join:output_type -> voting.Dump
+	53, // 124: voting.Sync.NodesChanged:output_type -> voting.Empty
+	53, // 125: voting.Sync.Sql:output_type -> voting.Empty
+	53, // 126: voting.Sync.NewKey:output_type -> voting.Empty
+	53, // 127: voting.Sync.NewKeys:output_type -> voting.Empty
+	53, // 128: voting.Sync.Ping:output_type -> voting.Empty
+	95, // [95:129] is the sub-list for method output_type
+	61, // [61:95] is the sub-list for method input_type
+	61, // [61:61] is the sub-list for extension type_name
+	61, // [61:61] is the sub-list for extension extendee
+	0,  // [0:61] is the sub-list for field type_name
 }
 
 func init() { file_proto_voting_proto_init() }
@@ -1204,8 +5442,188 @@ func file_proto_voting_proto_init() {
 				return nil
 			}
 		}
-		file_proto_voting_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VoterName); i {
+		file_proto_voting_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Membership); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterVotersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterVotersResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoterError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoterUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoterInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVotersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoterList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoterName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Challenge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Election); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Question); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1216,8 +5634,8 @@ func file_proto_voting_proto_init() {
 				return nil
 			}
 		}
-		file_proto_voting_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Status); i {
+		file_proto_voting_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupWeight); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1228,8 +5646,8 @@ func file_proto_voting_proto_init() {
 				return nil
 			}
 		}
-		file_proto_voting_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Challenge); i {
+		file_proto_voting_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1240,8 +5658,8 @@ func file_proto_voting_proto_init() {
 				return nil
 			}
 		}
-		file_proto_voting_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Response); i {
+		file_proto_voting_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRollRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1252,8 +5670,8 @@ func file_proto_voting_proto_init() {
 				return nil
 			}
 		}
-		file_proto_voting_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthRequest); i {
+		file_proto_voting_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegationScope); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1264,8 +5682,8 @@ func file_proto_voting_proto_init() {
 				return nil
 			}
 		}
-		file_proto_voting_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthToken); i {
+		file_proto_voting_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelegateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1276,8 +5694,8 @@ func file_proto_voting_proto_init() {
 				return nil
 			}
 		}
-		file_proto_voting_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Election); i {
+		file_proto_voting_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ElectionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1288,7 +5706,7 @@ func file_proto_voting_proto_init() {
 				return nil
 			}
 		}
-		file_proto_voting_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_voting_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Vote); i {
 			case 0:
 				return &v.state
@@ -1300,7 +5718,19 @@ func file_proto_voting_proto_init() {
 				return nil
 			}
 		}
-		file_proto_voting_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_voting_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Answer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ElectionName); i {
 			case 0:
 				return &v.state
@@ -1312,7 +5742,7 @@ func file_proto_voting_proto_init() {
 				return nil
 			}
 		}
-		file_proto_voting_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_voting_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VoteCount); i {
 			case 0:
 				return &v.state
@@ -1324,7 +5754,7 @@ func file_proto_voting_proto_init() {
 				return nil
 			}
 		}
-		file_proto_voting_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_voting_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ElectionResult); i {
 			case 0:
 				return &v.state
@@ -1336,7 +5766,247 @@ func file_proto_voting_proto_init() {
 				return nil
 			}
 		}
-		file_proto_voting_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_voting_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Countersignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrusteeContribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuestionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListElectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ElectionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteInAdjudication); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteInMerge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NominationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconContribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResultCertification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TurnoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TurnoutStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupTurnout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TurnoutStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ElectionEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NominationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NominationInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NominationList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ElectionList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Empty); i {
 			case 0:
 				return &v.state
@@ -1348,7 +6018,7 @@ func file_proto_voting_proto_init() {
 				return nil
 			}
 		}
-		file_proto_voting_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_voting_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NodesList); i {
 			case 0:
 				return &v.state
@@ -1360,7 +6030,7 @@ func file_proto_voting_proto_init() {
 				return nil
 			}
 		}
-		file_proto_voting_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_voting_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NodeIdentifier); i {
 			case 0:
 				return &v.state
@@ -1372,7 +6042,7 @@ func file_proto_voting_proto_init() {
 				return nil
 			}
 		}
-		file_proto_voting_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_voting_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Key); i {
 			case 0:
 				return &v.state
@@ -1384,7 +6054,19 @@ func file_proto_voting_proto_init() {
 				return nil
 			}
 		}
-		file_proto_voting_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_voting_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_voting_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Dump); i {
 			case 0:
 				return &v.state
@@ -1396,7 +6078,7 @@ func file_proto_voting_proto_init() {
 				return nil
 			}
 		}
-		file_proto_voting_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_voting_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SqlRequest); i {
 			case 0:
 				return &v.state
@@ -1414,13 +6096,14 @@ func file_proto_voting_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_voting_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   18,
+			NumEnums:      5,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
 		GoTypes:           file_proto_voting_proto_goTypes,
 		DependencyIndexes: file_proto_voting_proto_depIdxs,
+		EnumInfos:         file_proto_voting_proto_enumTypes,
 		MessageInfos:      file_proto_voting_proto_msgTypes,
 	}.Build()
 	File_proto_voting_proto = out.File
@@ -24,6 +24,12 @@ const _ = grpc.SupportPackageIsVersion7
 type RegistrationClient interface {
 	RegisterVoter(ctx context.Context, in *Voter, opts ...grpc.CallOption) (*Status, error)
 	UnregisterVoter(ctx context.Context, in *VoterName, opts ...grpc.CallOption) (*Status, error)
+	AddMembership(ctx context.Context, in *Membership, opts ...grpc.CallOption) (*Status, error)
+	RemoveMembership(ctx context.Context, in *Membership, opts ...grpc.CallOption) (*Status, error)
+	UpdateVoter(ctx context.Context, in *VoterUpdate, opts ...grpc.CallOption) (*Status, error)
+	GetVoter(ctx context.Context, in *VoterName, opts ...grpc.CallOption) (*VoterInfo, error)
+	ListVoters(ctx context.Context, in *ListVotersRequest, opts ...grpc.CallOption) (*VoterList, error)
+	RegisterVoters(ctx context.Context, opts ...grpc.CallOption) (Registration_RegisterVotersClient, error)
 }
 
 type registrationClient struct {
@@ -52,12 +58,97 @@ func (c *registrationClient) UnregisterVoter(ctx context.Context, in *VoterName,
 	return out, nil
 }
 
+func (c *registrationClient) AddMembership(ctx context.Context, in *Membership, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/voting.Registration/AddMembership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationClient) RemoveMembership(ctx context.Context, in *Membership, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/voting.Registration/RemoveMembership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationClient) UpdateVoter(ctx context.Context, in *VoterUpdate, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/voting.Registration/UpdateVoter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationClient) GetVoter(ctx context.Context, in *VoterName, opts ...grpc.CallOption) (*VoterInfo, error) {
+	out := new(VoterInfo)
+	err := c.cc.Invoke(ctx, "/voting.Registration/GetVoter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationClient) ListVoters(ctx context.Context, in *ListVotersRequest, opts ...grpc.CallOption) (*VoterList, error) {
+	out := new(VoterList)
+	err := c.cc.Invoke(ctx, "/voting.Registration/ListVoters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registrationClient) RegisterVoters(ctx context.Context, opts ...grpc.CallOption) (Registration_RegisterVotersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Registration_ServiceDesc.Streams[0], "/voting.Registration/RegisterVoters", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &registrationRegisterVotersClient{stream}
+	return x, nil
+}
+
+type Registration_RegisterVotersClient interface {
+	Send(*RegisterVotersRequest) error
+	CloseAndRecv() (*RegisterVotersResult, error)
+	grpc.ClientStream
+}
+
+type registrationRegisterVotersClient struct {
+	grpc.ClientStream
+}
+
+func (x *registrationRegisterVotersClient) Send(m *RegisterVotersRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *registrationRegisterVotersClient) CloseAndRecv() (*RegisterVotersResult, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(RegisterVotersResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RegistrationServer is the server API for Registration service.
 // All implementations must embed UnimplementedRegistrationServer
 // for forward compatibility
 type RegistrationServer interface {
 	RegisterVoter(context.Context, *Voter) (*Status, error)
 	UnregisterVoter(context.Context, *VoterName) (*Status, error)
+	AddMembership(context.Context, *Membership) (*Status, error)
+	RemoveMembership(context.Context, *Membership) (*Status, error)
+	UpdateVoter(context.Context, *VoterUpdate) (*Status, error)
+	GetVoter(context.Context, *VoterName) (*VoterInfo, error)
+	ListVoters(context.Context, *ListVotersRequest) (*VoterList, error)
+	RegisterVoters(Registration_RegisterVotersServer) error
 	mustEmbedUnimplementedRegistrationServer()
 }
 
@@ -71,6 +162,24 @@ func (UnimplementedRegistrationServer) RegisterVoter(context.Context, *Voter) (*
 func (UnimplementedRegistrationServer) UnregisterVoter(context.Context, *VoterName) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnregisterVoter not implemented")
 }
+func (UnimplementedRegistrationServer) AddMembership(context.Context, *Membership) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMembership not implemented")
+}
+func (UnimplementedRegistrationServer) RemoveMembership(context.Context, *Membership) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMembership not implemented")
+}
+func (UnimplementedRegistrationServer) UpdateVoter(context.Context, *VoterUpdate) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVoter not implemented")
+}
+func (UnimplementedRegistrationServer) GetVoter(context.Context, *VoterName) (*VoterInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVoter not implemented")
+}
+func (UnimplementedRegistrationServer) ListVoters(context.Context, *ListVotersRequest) (*VoterList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVoters not implemented")
+}
+func (UnimplementedRegistrationServer) RegisterVoters(Registration_RegisterVotersServer) error {
+	return status.Errorf(codes.Unimplemented, "method RegisterVoters not implemented")
+}
 func (UnimplementedRegistrationServer) mustEmbedUnimplementedRegistrationServer() {}
 
 // UnsafeRegistrationServer may be embedded to opt out of forward compatibility for this service.
@@ -84,260 +193,908 @@ func RegisterRegistrationServer(s grpc.ServiceRegistrar, srv RegistrationServer)
 	s.RegisterService(&Registration_ServiceDesc, srv)
 }
 
-func _Registration_RegisterVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Voter)
+func _Registration_RegisterVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Voter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServer).RegisterVoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.Registration/RegisterVoter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServer).RegisterVoter(ctx, req.(*Voter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registration_UnregisterVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoterName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServer).UnregisterVoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.Registration/UnregisterVoter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServer).UnregisterVoter(ctx, req.(*VoterName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registration_AddMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Membership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServer).AddMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.Registration/AddMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServer).AddMembership(ctx, req.(*Membership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registration_RemoveMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Membership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServer).RemoveMembership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.Registration/RemoveMembership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServer).RemoveMembership(ctx, req.(*Membership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registration_UpdateVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoterUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServer).UpdateVoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.Registration/UpdateVoter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServer).UpdateVoter(ctx, req.(*VoterUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registration_GetVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoterName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServer).GetVoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.Registration/GetVoter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServer).GetVoter(ctx, req.(*VoterName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registration_ListVoters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVotersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistrationServer).ListVoters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.Registration/ListVoters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistrationServer).ListVoters(ctx, req.(*ListVotersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registration_RegisterVoters_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RegistrationServer).RegisterVoters(&registrationRegisterVotersServer{stream})
+}
+
+type Registration_RegisterVotersServer interface {
+	SendAndClose(*RegisterVotersResult) error
+	Recv() (*RegisterVotersRequest, error)
+	grpc.ServerStream
+}
+
+type registrationRegisterVotersServer struct {
+	grpc.ServerStream
+}
+
+func (x *registrationRegisterVotersServer) SendAndClose(m *RegisterVotersResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *registrationRegisterVotersServer) Recv() (*RegisterVotersRequest, error) {
+	m := new(RegisterVotersRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Registration_ServiceDesc is the grpc.ServiceDesc for Registration service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Registration_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "voting.Registration",
+	HandlerType: (*RegistrationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterVoter",
+			Handler:    _Registration_RegisterVoter_Handler,
+		},
+		{
+			MethodName: "UnregisterVoter",
+			Handler:    _Registration_UnregisterVoter_Handler,
+		},
+		{
+			MethodName: "AddMembership",
+			Handler:    _Registration_AddMembership_Handler,
+		},
+		{
+			MethodName: "RemoveMembership",
+			Handler:    _Registration_RemoveMembership_Handler,
+		},
+		{
+			MethodName: "UpdateVoter",
+			Handler:    _Registration_UpdateVoter_Handler,
+		},
+		{
+			MethodName: "GetVoter",
+			Handler:    _Registration_GetVoter_Handler,
+		},
+		{
+			MethodName: "ListVoters",
+			Handler:    _Registration_ListVoters_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RegisterVoters",
+			Handler:       _Registration_RegisterVoters_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/voting.proto",
+}
+
+// EVotingClient is the client API for EVoting service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EVotingClient interface {
+	PreAuth(ctx context.Context, in *VoterName, opts ...grpc.CallOption) (*Challenge, error)
+	Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthToken, error)
+	CreateElection(ctx context.Context, in *Election, opts ...grpc.CallOption) (*Status, error)
+	CastVote(ctx context.Context, in *Vote, opts ...grpc.CallOption) (*Status, error)
+	GetResult(ctx context.Context, in *ElectionName, opts ...grpc.CallOption) (*ElectionResult, error)
+	ListElections(ctx context.Context, in *ListElectionsRequest, opts ...grpc.CallOption) (*ElectionList, error)
+	GetElection(ctx context.Context, in *ElectionName, opts ...grpc.CallOption) (*ElectionInfo, error)
+	UpdateRoll(ctx context.Context, in *UpdateRollRequest, opts ...grpc.CallOption) (*Status, error)
+	SnapshotElectorate(ctx context.Context, in *ElectionRequest, opts ...grpc.CallOption) (*Status, error)
+	Delegate(ctx context.Context, in *DelegateRequest, opts ...grpc.CallOption) (*Status, error)
+	RevokeDelegation(ctx context.Context, in *DelegationScope, opts ...grpc.CallOption) (*Status, error)
+	AdjudicateWriteIns(ctx context.Context, in *WriteInAdjudication, opts ...grpc.CallOption) (*Status, error)
+	Nominate(ctx context.Context, in *NominationRequest, opts ...grpc.CallOption) (*Status, error)
+	SecondNomination(ctx context.Context, in *NominationRequest, opts ...grpc.CallOption) (*Status, error)
+	RespondNomination(ctx context.Context, in *NominationResponse, opts ...grpc.CallOption) (*Status, error)
+	ListNominations(ctx context.Context, in *ElectionName, opts ...grpc.CallOption) (*NominationList, error)
+	ContributeToBeacon(ctx context.Context, in *BeaconContribution, opts ...grpc.CallOption) (*Status, error)
+	CertifyResult(ctx context.Context, in *ResultCertification, opts ...grpc.CallOption) (*Status, error)
+	GetTurnout(ctx context.Context, in *TurnoutRequest, opts ...grpc.CallOption) (*TurnoutStats, error)
+	WatchElection(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (EVoting_WatchElectionClient, error)
+}
+
+type eVotingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEVotingClient(cc grpc.ClientConnInterface) EVotingClient {
+	return &eVotingClient{cc}
+}
+
+func (c *eVotingClient) PreAuth(ctx context.Context, in *VoterName, opts ...grpc.CallOption) (*Challenge, error) {
+	out := new(Challenge)
+	err := c.cc.Invoke(ctx, "/voting.eVoting/PreAuth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVotingClient) Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthToken, error) {
+	out := new(AuthToken)
+	err := c.cc.Invoke(ctx, "/voting.eVoting/Auth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVotingClient) CreateElection(ctx context.Context, in *Election, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/voting.eVoting/CreateElection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVotingClient) CastVote(ctx context.Context, in *Vote, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/voting.eVoting/CastVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVotingClient) GetResult(ctx context.Context, in *ElectionName, opts ...grpc.CallOption) (*ElectionResult, error) {
+	out := new(ElectionResult)
+	err := c.cc.Invoke(ctx, "/voting.eVoting/GetResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVotingClient) ListElections(ctx context.Context, in *ListElectionsRequest, opts ...grpc.CallOption) (*ElectionList, error) {
+	out := new(ElectionList)
+	err := c.cc.Invoke(ctx, "/voting.eVoting/ListElections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVotingClient) GetElection(ctx context.Context, in *ElectionName, opts ...grpc.CallOption) (*ElectionInfo, error) {
+	out := new(ElectionInfo)
+	err := c.cc.Invoke(ctx, "/voting.eVoting/GetElection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVotingClient) UpdateRoll(ctx context.Context, in *UpdateRollRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/voting.eVoting/UpdateRoll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVotingClient) SnapshotElectorate(ctx context.Context, in *ElectionRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/voting.eVoting/SnapshotElectorate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVotingClient) Delegate(ctx context.Context, in *DelegateRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/voting.eVoting/Delegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVotingClient) RevokeDelegation(ctx context.Context, in *DelegationScope, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/voting.eVoting/RevokeDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVotingClient) AdjudicateWriteIns(ctx context.Context, in *WriteInAdjudication, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/voting.eVoting/AdjudicateWriteIns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVotingClient) Nominate(ctx context.Context, in *NominationRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/voting.eVoting/Nominate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVotingClient) SecondNomination(ctx context.Context, in *NominationRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/voting.eVoting/SecondNomination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVotingClient) RespondNomination(ctx context.Context, in *NominationResponse, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/voting.eVoting/RespondNomination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVotingClient) ListNominations(ctx context.Context, in *ElectionName, opts ...grpc.CallOption) (*NominationList, error) {
+	out := new(NominationList)
+	err := c.cc.Invoke(ctx, "/voting.eVoting/ListNominations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVotingClient) ContributeToBeacon(ctx context.Context, in *BeaconContribution, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/voting.eVoting/ContributeToBeacon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVotingClient) CertifyResult(ctx context.Context, in *ResultCertification, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/voting.eVoting/CertifyResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVotingClient) GetTurnout(ctx context.Context, in *TurnoutRequest, opts ...grpc.CallOption) (*TurnoutStats, error) {
+	out := new(TurnoutStats)
+	err := c.cc.Invoke(ctx, "/voting.eVoting/GetTurnout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eVotingClient) WatchElection(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (EVoting_WatchElectionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EVoting_ServiceDesc.Streams[0], "/voting.eVoting/WatchElection", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eVotingWatchElectionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EVoting_WatchElectionClient interface {
+	Recv() (*ElectionEvent, error)
+	grpc.ClientStream
+}
+
+type eVotingWatchElectionClient struct {
+	grpc.ClientStream
+}
+
+func (x *eVotingWatchElectionClient) Recv() (*ElectionEvent, error) {
+	m := new(ElectionEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EVotingServer is the server API for EVoting service.
+// All implementations must embed UnimplementedEVotingServer
+// for forward compatibility
+type EVotingServer interface {
+	PreAuth(context.Context, *VoterName) (*Challenge, error)
+	Auth(context.Context, *AuthRequest) (*AuthToken, error)
+	CreateElection(context.Context, *Election) (*Status, error)
+	CastVote(context.Context, *Vote) (*Status, error)
+	GetResult(context.Context, *ElectionName) (*ElectionResult, error)
+	ListElections(context.Context, *ListElectionsRequest) (*ElectionList, error)
+	GetElection(context.Context, *ElectionName) (*ElectionInfo, error)
+	UpdateRoll(context.Context, *UpdateRollRequest) (*Status, error)
+	SnapshotElectorate(context.Context, *ElectionRequest) (*Status, error)
+	Delegate(context.Context, *DelegateRequest) (*Status, error)
+	RevokeDelegation(context.Context, *DelegationScope) (*Status, error)
+	AdjudicateWriteIns(context.Context, *WriteInAdjudication) (*Status, error)
+	Nominate(context.Context, *NominationRequest) (*Status, error)
+	SecondNomination(context.Context, *NominationRequest) (*Status, error)
+	RespondNomination(context.Context, *NominationResponse) (*Status, error)
+	ListNominations(context.Context, *ElectionName) (*NominationList, error)
+	ContributeToBeacon(context.Context, *BeaconContribution) (*Status, error)
+	CertifyResult(context.Context, *ResultCertification) (*Status, error)
+	GetTurnout(context.Context, *TurnoutRequest) (*TurnoutStats, error)
+	WatchElection(*WatchRequest, EVoting_WatchElectionServer) error
+	mustEmbedUnimplementedEVotingServer()
+}
+
+// UnimplementedEVotingServer must be embedded to have forward compatible implementations.
+type UnimplementedEVotingServer struct {
+}
+
+func (UnimplementedEVotingServer) PreAuth(context.Context, *VoterName) (*Challenge, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreAuth not implemented")
+}
+func (UnimplementedEVotingServer) Auth(context.Context, *AuthRequest) (*AuthToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auth not implemented")
+}
+func (UnimplementedEVotingServer) CreateElection(context.Context, *Election) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateElection not implemented")
+}
+func (UnimplementedEVotingServer) CastVote(context.Context, *Vote) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CastVote not implemented")
+}
+func (UnimplementedEVotingServer) GetResult(context.Context, *ElectionName) (*ElectionResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResult not implemented")
+}
+func (UnimplementedEVotingServer) ListElections(context.Context, *ListElectionsRequest) (*ElectionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListElections not implemented")
+}
+func (UnimplementedEVotingServer) GetElection(context.Context, *ElectionName) (*ElectionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetElection not implemented")
+}
+func (UnimplementedEVotingServer) UpdateRoll(context.Context, *UpdateRollRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoll not implemented")
+}
+func (UnimplementedEVotingServer) SnapshotElectorate(context.Context, *ElectionRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotElectorate not implemented")
+}
+func (UnimplementedEVotingServer) Delegate(context.Context, *DelegateRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delegate not implemented")
+}
+func (UnimplementedEVotingServer) RevokeDelegation(context.Context, *DelegationScope) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeDelegation not implemented")
+}
+func (UnimplementedEVotingServer) AdjudicateWriteIns(context.Context, *WriteInAdjudication) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjudicateWriteIns not implemented")
+}
+func (UnimplementedEVotingServer) Nominate(context.Context, *NominationRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Nominate not implemented")
+}
+func (UnimplementedEVotingServer) SecondNomination(context.Context, *NominationRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecondNomination not implemented")
+}
+func (UnimplementedEVotingServer) RespondNomination(context.Context, *NominationResponse) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondNomination not implemented")
+}
+func (UnimplementedEVotingServer) ListNominations(context.Context, *ElectionName) (*NominationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNominations not implemented")
+}
+func (UnimplementedEVotingServer) ContributeToBeacon(context.Context, *BeaconContribution) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContributeToBeacon not implemented")
+}
+func (UnimplementedEVotingServer) CertifyResult(context.Context, *ResultCertification) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CertifyResult not implemented")
+}
+func (UnimplementedEVotingServer) GetTurnout(context.Context, *TurnoutRequest) (*TurnoutStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTurnout not implemented")
+}
+func (UnimplementedEVotingServer) WatchElection(*WatchRequest, EVoting_WatchElectionServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchElection not implemented")
+}
+func (UnimplementedEVotingServer) mustEmbedUnimplementedEVotingServer() {}
+
+// UnsafeEVotingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EVotingServer will
+// result in compilation errors.
+type UnsafeEVotingServer interface {
+	mustEmbedUnimplementedEVotingServer()
+}
+
+func RegisterEVotingServer(s grpc.ServiceRegistrar, srv EVotingServer) {
+	s.RegisterService(&EVoting_ServiceDesc, srv)
+}
+
+func _EVoting_PreAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoterName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVotingServer).PreAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.eVoting/PreAuth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVotingServer).PreAuth(ctx, req.(*VoterName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EVoting_Auth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVotingServer).Auth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.eVoting/Auth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVotingServer).Auth(ctx, req.(*AuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EVoting_CreateElection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Election)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVotingServer).CreateElection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.eVoting/CreateElection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVotingServer).CreateElection(ctx, req.(*Election))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EVoting_CastVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Vote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVotingServer).CastVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.eVoting/CastVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVotingServer).CastVote(ctx, req.(*Vote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EVoting_GetResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElectionName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVotingServer).GetResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.eVoting/GetResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVotingServer).GetResult(ctx, req.(*ElectionName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EVoting_ListElections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListElectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVotingServer).ListElections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.eVoting/ListElections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVotingServer).ListElections(ctx, req.(*ListElectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EVoting_GetElection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElectionName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EVotingServer).GetElection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.eVoting/GetElection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVotingServer).GetElection(ctx, req.(*ElectionName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EVoting_UpdateRoll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRollRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistrationServer).RegisterVoter(ctx, in)
+		return srv.(EVotingServer).UpdateRoll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/voting.Registration/RegisterVoter",
+		FullMethod: "/voting.eVoting/UpdateRoll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistrationServer).RegisterVoter(ctx, req.(*Voter))
+		return srv.(EVotingServer).UpdateRoll(ctx, req.(*UpdateRollRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registration_UnregisterVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VoterName)
+func _EVoting_SnapshotElectorate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistrationServer).UnregisterVoter(ctx, in)
+		return srv.(EVotingServer).SnapshotElectorate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/voting.Registration/UnregisterVoter",
+		FullMethod: "/voting.eVoting/SnapshotElectorate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistrationServer).UnregisterVoter(ctx, req.(*VoterName))
+		return srv.(EVotingServer).SnapshotElectorate(ctx, req.(*ElectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// Registration_ServiceDesc is the grpc.ServiceDesc for Registration service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Registration_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "voting.Registration",
-	HandlerType: (*RegistrationServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterVoter",
-			Handler:    _Registration_RegisterVoter_Handler,
-		},
-		{
-			MethodName: "UnregisterVoter",
-			Handler:    _Registration_UnregisterVoter_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/voting.proto",
-}
-
-// EVotingClient is the client API for EVoting service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type EVotingClient interface {
-	PreAuth(ctx context.Context, in *VoterName, opts ...grpc.CallOption) (*Challenge, error)
-	Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthToken, error)
-	CreateElection(ctx context.Context, in *Election, opts ...grpc.CallOption) (*Status, error)
-	CastVote(ctx context.Context, in *Vote, opts ...grpc.CallOption) (*Status, error)
-	GetResult(ctx context.Context, in *ElectionName, opts ...grpc.CallOption) (*ElectionResult, error)
-}
-
-type eVotingClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewEVotingClient(cc grpc.ClientConnInterface) EVotingClient {
-	return &eVotingClient{cc}
-}
-
-func (c *eVotingClient) PreAuth(ctx context.Context, in *VoterName, opts ...grpc.CallOption) (*Challenge, error) {
-	out := new(Challenge)
-	err := c.cc.Invoke(ctx, "/voting.eVoting/PreAuth", in, out, opts...)
-	if err != nil {
+func _EVoting_Delegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(EVotingServer).Delegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.eVoting/Delegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVotingServer).Delegate(ctx, req.(*DelegateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *eVotingClient) Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthToken, error) {
-	out := new(AuthToken)
-	err := c.cc.Invoke(ctx, "/voting.eVoting/Auth", in, out, opts...)
-	if err != nil {
+func _EVoting_RevokeDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelegationScope)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(EVotingServer).RevokeDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.eVoting/RevokeDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVotingServer).RevokeDelegation(ctx, req.(*DelegationScope))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *eVotingClient) CreateElection(ctx context.Context, in *Election, opts ...grpc.CallOption) (*Status, error) {
-	out := new(Status)
-	err := c.cc.Invoke(ctx, "/voting.eVoting/CreateElection", in, out, opts...)
-	if err != nil {
+func _EVoting_AdjudicateWriteIns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteInAdjudication)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(EVotingServer).AdjudicateWriteIns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.eVoting/AdjudicateWriteIns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVotingServer).AdjudicateWriteIns(ctx, req.(*WriteInAdjudication))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *eVotingClient) CastVote(ctx context.Context, in *Vote, opts ...grpc.CallOption) (*Status, error) {
-	out := new(Status)
-	err := c.cc.Invoke(ctx, "/voting.eVoting/CastVote", in, out, opts...)
-	if err != nil {
+func _EVoting_Nominate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NominationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(EVotingServer).Nominate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.eVoting/Nominate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVotingServer).Nominate(ctx, req.(*NominationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *eVotingClient) GetResult(ctx context.Context, in *ElectionName, opts ...grpc.CallOption) (*ElectionResult, error) {
-	out := new(ElectionResult)
-	err := c.cc.Invoke(ctx, "/voting.eVoting/GetResult", in, out, opts...)
-	if err != nil {
+func _EVoting_SecondNomination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NominationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// EVotingServer is the server API for EVoting service.
-// All implementations must embed UnimplementedEVotingServer
-// for forward compatibility
-type EVotingServer interface {
-	PreAuth(context.Context, *VoterName) (*Challenge, error)
-	Auth(context.Context, *AuthRequest) (*AuthToken, error)
-	CreateElection(context.Context, *Election) (*Status, error)
-	CastVote(context.Context, *Vote) (*Status, error)
-	GetResult(context.Context, *ElectionName) (*ElectionResult, error)
-	mustEmbedUnimplementedEVotingServer()
-}
-
-// UnimplementedEVotingServer must be embedded to have forward compatible implementations.
-type UnimplementedEVotingServer struct {
-}
-
-func (UnimplementedEVotingServer) PreAuth(context.Context, *VoterName) (*Challenge, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PreAuth not implemented")
-}
-func (UnimplementedEVotingServer) Auth(context.Context, *AuthRequest) (*AuthToken, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Auth not implemented")
-}
-func (UnimplementedEVotingServer) CreateElection(context.Context, *Election) (*Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateElection not implemented")
-}
-func (UnimplementedEVotingServer) CastVote(context.Context, *Vote) (*Status, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CastVote not implemented")
-}
-func (UnimplementedEVotingServer) GetResult(context.Context, *ElectionName) (*ElectionResult, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetResult not implemented")
-}
-func (UnimplementedEVotingServer) mustEmbedUnimplementedEVotingServer() {}
-
-// UnsafeEVotingServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to EVotingServer will
-// result in compilation errors.
-type UnsafeEVotingServer interface {
-	mustEmbedUnimplementedEVotingServer()
-}
-
-func RegisterEVotingServer(s grpc.ServiceRegistrar, srv EVotingServer) {
-	s.RegisterService(&EVoting_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(EVotingServer).SecondNomination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.eVoting/SecondNomination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EVotingServer).SecondNomination(ctx, req.(*NominationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _EVoting_PreAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VoterName)
+func _EVoting_RespondNomination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NominationResponse)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EVotingServer).PreAuth(ctx, in)
+		return srv.(EVotingServer).RespondNomination(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/voting.eVoting/PreAuth",
+		FullMethod: "/voting.eVoting/RespondNomination",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EVotingServer).PreAuth(ctx, req.(*VoterName))
+		return srv.(EVotingServer).RespondNomination(ctx, req.(*NominationResponse))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EVoting_Auth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AuthRequest)
+func _EVoting_ListNominations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ElectionName)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EVotingServer).Auth(ctx, in)
+		return srv.(EVotingServer).ListNominations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/voting.eVoting/Auth",
+		FullMethod: "/voting.eVoting/ListNominations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EVotingServer).Auth(ctx, req.(*AuthRequest))
+		return srv.(EVotingServer).ListNominations(ctx, req.(*ElectionName))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EVoting_CreateElection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Election)
+func _EVoting_ContributeToBeacon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeaconContribution)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EVotingServer).CreateElection(ctx, in)
+		return srv.(EVotingServer).ContributeToBeacon(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/voting.eVoting/CreateElection",
+		FullMethod: "/voting.eVoting/ContributeToBeacon",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EVotingServer).CreateElection(ctx, req.(*Election))
+		return srv.(EVotingServer).ContributeToBeacon(ctx, req.(*BeaconContribution))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EVoting_CastVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Vote)
+func _EVoting_CertifyResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResultCertification)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EVotingServer).CastVote(ctx, in)
+		return srv.(EVotingServer).CertifyResult(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/voting.eVoting/CastVote",
+		FullMethod: "/voting.eVoting/CertifyResult",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EVotingServer).CastVote(ctx, req.(*Vote))
+		return srv.(EVotingServer).CertifyResult(ctx, req.(*ResultCertification))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EVoting_GetResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ElectionName)
+func _EVoting_GetTurnout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TurnoutRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EVotingServer).GetResult(ctx, in)
+		return srv.(EVotingServer).GetTurnout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/voting.eVoting/GetResult",
+		FullMethod: "/voting.eVoting/GetTurnout",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EVotingServer).GetResult(ctx, req.(*ElectionName))
+		return srv.(EVotingServer).GetTurnout(ctx, req.(*TurnoutRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EVoting_WatchElection_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EVotingServer).WatchElection(m, &eVotingWatchElectionServer{stream})
+}
+
+type EVoting_WatchElectionServer interface {
+	Send(*ElectionEvent) error
+	grpc.ServerStream
+}
+
+type eVotingWatchElectionServer struct {
+	grpc.ServerStream
+}
+
+func (x *eVotingWatchElectionServer) Send(m *ElectionEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // EVoting_ServiceDesc is the grpc.ServiceDesc for EVoting service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -365,8 +1122,70 @@ var EVoting_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetResult",
 			Handler:    _EVoting_GetResult_Handler,
 		},
+		{
+			MethodName: "ListElections",
+			Handler:    _EVoting_ListElections_Handler,
+		},
+		{
+			MethodName: "GetElection",
+			Handler:    _EVoting_GetElection_Handler,
+		},
+		{
+			MethodName: "UpdateRoll",
+			Handler:    _EVoting_UpdateRoll_Handler,
+		},
+		{
+			MethodName: "SnapshotElectorate",
+			Handler:    _EVoting_SnapshotElectorate_Handler,
+		},
+		{
+			MethodName: "Delegate",
+			Handler:    _EVoting_Delegate_Handler,
+		},
+		{
+			MethodName: "RevokeDelegation",
+			Handler:    _EVoting_RevokeDelegation_Handler,
+		},
+		{
+			MethodName: "AdjudicateWriteIns",
+			Handler:    _EVoting_AdjudicateWriteIns_Handler,
+		},
+		{
+			MethodName: "Nominate",
+			Handler:    _EVoting_Nominate_Handler,
+		},
+		{
+			MethodName: "SecondNomination",
+			Handler:    _EVoting_SecondNomination_Handler,
+		},
+		{
+			MethodName: "RespondNomination",
+			Handler:    _EVoting_RespondNomination_Handler,
+		},
+		{
+			MethodName: "ListNominations",
+			Handler:    _EVoting_ListNominations_Handler,
+		},
+		{
+			MethodName: "ContributeToBeacon",
+			Handler:    _EVoting_ContributeToBeacon_Handler,
+		},
+		{
+			MethodName: "CertifyResult",
+			Handler:    _EVoting_CertifyResult_Handler,
+		},
+		{
+			MethodName: "GetTurnout",
+			Handler:    _EVoting_GetTurnout_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchElection",
+			Handler:       _EVoting_WatchElection_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/voting.proto",
 }
 
@@ -378,6 +1197,7 @@ type SyncClient interface {
 	NodesChanged(ctx context.Context, in *NodesList, opts ...grpc.CallOption) (*Empty, error)
 	Sql(ctx context.Context, in *SqlRequest, opts ...grpc.CallOption) (*Empty, error)
 	NewKey(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Empty, error)
+	NewKeys(ctx context.Context, in *KeyList, opts ...grpc.CallOption) (*Empty, error)
 	Ping(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 }
 
@@ -425,6 +1245,15 @@ func (c *syncClient) NewKey(ctx context.Context, in *Key, opts ...grpc.CallOptio
 	return out, nil
 }
 
+func (c *syncClient) NewKeys(ctx context.Context, in *KeyList, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/voting.Sync/NewKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *syncClient) Ping(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/voting.Sync/Ping", in, out, opts...)
@@ -442,6 +1271,7 @@ type SyncServer interface {
 	NodesChanged(context.Context, *NodesList) (*Empty, error)
 	Sql(context.Context, *SqlRequest) (*Empty, error)
 	NewKey(context.Context, *Key) (*Empty, error)
+	NewKeys(context.Context, *KeyList) (*Empty, error)
 	Ping(context.Context, *Empty) (*Empty, error)
 	mustEmbedUnimplementedSyncServer()
 }
@@ -462,6 +1292,9 @@ func (UnimplementedSyncServer) Sql(context.Context, *SqlRequest) (*Empty, error)
 func (UnimplementedSyncServer) NewKey(context.Context, *Key) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NewKey not implemented")
 }
+func (UnimplementedSyncServer) NewKeys(context.Context, *KeyList) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewKeys not implemented")
+}
 func (UnimplementedSyncServer) Ping(context.Context, *Empty) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
 }
@@ -550,6 +1383,24 @@ func _Sync_NewKey_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sync_NewKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServer).NewKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/voting.Sync/NewKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServer).NewKeys(ctx, req.(*KeyList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Sync_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -591,6 +1442,10 @@ var Sync_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NewKey",
 			Handler:    _Sync_NewKey_Handler,
 		},
+		{
+			MethodName: "NewKeys",
+			Handler:    _Sync_NewKeys_Handler,
+		},
 		{
 			MethodName: "Ping",
 			Handler:    _Sync_Ping_Handler,
@@ -0,0 +1,64 @@
+package store
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"errors"
+)
+
+// hexText is how bytes are stored, as hex for both backends, NULL if nil.
+func hexText(b []byte) sql.NullString {
+	return sql.NullString{String: hex.EncodeToString(b), Valid: b != nil}
+}
+
+func hexBytes(s sql.NullString) ([]byte, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	return hex.DecodeString(s.String)
+}
+
+// trustees returns trustees of an election in order of name.
+func (s *sqlStore) trustees(electionID int64) ([]Trustee, error) {
+	rows, err := s.db.Query(`SELECT "name", "contribution" FROM "election_trustees" WHERE "election_id" = $1 ORDER BY "name"`, electionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	list := []Trustee{}
+	for rows.Next() {
+		var (
+			t Trustee
+			contribution sql.NullString
+		)
+		err = rows.Scan(&t.Name, &contribution)
+		if err != nil {
+			return nil, err
+		}
+		t.Contribution, err = hexBytes(contribution)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, t)
+	}
+	return list, rows.Err()
+}
+
+func (s *sqlStore) Contribute(electionID int64, trustee string, contribution []byte) error {
+	return s.serializable(func(tx *sql.Tx) error {
+		var current sql.NullString
+		err := tx.QueryRow(`SELECT "contribution" FROM "election_trustees" WHERE "election_id" = $1 AND "name" = $2`, electionID, trustee).Scan(&current)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if current.Valid {
+			return ErrExists
+		}
+		_, err = tx.Exec(`UPDATE "election_trustees" SET "contribution" = $3 WHERE "election_id" = $1 AND "name" = $2`,
+			electionID, trustee, hexText(contribution))
+		return err
+	})
+}
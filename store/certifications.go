@@ -0,0 +1,41 @@
+package store
+
+import "database/sql"
+
+// officers returns certifying officers of an election in order of name.
+func (s *sqlStore) officers(electionID int64) ([]Officer, error) {
+	rows, err := s.db.Query(`SELECT "name", "signature" FROM "election_officers" WHERE "election_id" = $1 ORDER BY "name"`, electionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	list := []Officer{}
+	for rows.Next() {
+		var (
+			o Officer
+			signature sql.NullString
+		)
+		err = rows.Scan(&o.Name, &signature)
+		if err != nil {
+			return nil, err
+		}
+		o.Signature, err = hexBytes(signature)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, o)
+	}
+	return list, rows.Err()
+}
+
+func (s *sqlStore) Countersign(electionID int64, officer string, signature []byte) error {
+	res, err := s.db.Exec(`UPDATE "election_officers" SET "signature" = $3 WHERE "election_id" = $1 AND "name" = $2`,
+		electionID, officer, hexText(signature))
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
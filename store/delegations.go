@@ -0,0 +1,225 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+)
+
+// scope is an SQL condition on delegations in the scope of d, with $2 as
+// the argument from scopeArg.
+func scope(d Delegation) string {
+	if d.ElectionID != 0 {
+		return `"election_id" = $2`
+	}
+	return `"election_id" IS NULL AND "group" = $2`
+}
+
+func scopeArg(d Delegation) any {
+	if d.ElectionID != 0 {
+		return d.ElectionID
+	}
+	return d.Group
+}
+
+func (s *sqlStore) Delegate(d Delegation, at time.Time) error {
+	return s.serializable(func(tx *sql.Tx) error {
+		var n int
+		err := tx.QueryRow(`SELECT COUNT(*) FROM "users" WHERE "name" = $1`, d.Delegate).Scan(&n)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrVoterNotFound
+		}
+
+		// follow the delegate's own delegations to see if they come back
+		seen := map[string]bool{}
+		for next := d.Delegate; !seen[next]; {
+			if next == d.Delegator {
+				return ErrDelegationCycle
+			}
+			seen[next] = true
+			err = tx.QueryRow(`SELECT "delegate" FROM "delegations" WHERE "delegator" = $1 AND "revoked_at" IS NULL AND ` + scope(d),
+				next, scopeArg(d)).Scan(&next)
+			if errors.Is(err, sql.ErrNoRows) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.Exec(`UPDATE "delegations" SET "revoked_at" = $3 WHERE "delegator" = $1 AND "revoked_at" IS NULL AND ` + scope(d),
+			d.Delegator, scopeArg(d), timeText(at))
+		if err != nil {
+			return err
+		}
+		election := sql.NullInt64{Int64: d.ElectionID, Valid: d.ElectionID != 0}
+		group := sql.NullString{String: d.Group, Valid: d.ElectionID == 0}
+		_, err = tx.Exec(`INSERT INTO "delegations" ("delegator", "delegate", "election_id", "group", "created_at") VALUES ($1, $2, $3, $4, $5)`,
+			d.Delegator, d.Delegate, election, group, timeText(at))
+		return err
+	})
+}
+
+func (s *sqlStore) RevokeDelegation(delegator string, electionID int64, group string, at time.Time) error {
+	d := Delegation{ElectionID: electionID, Group: group}
+	res, err := s.db.Exec(`UPDATE "delegations" SET "revoked_at" = $3 WHERE "delegator" = $1 AND "revoked_at" IS NULL AND ` + scope(d),
+		delegator, scopeArg(d), timeText(at))
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// proxy is a vote by proxy with the ballot of delegate.
+type proxy struct {
+	delegate	string
+	weight	int64
+}
+
+// proxies resolves delegations in force at the end of an election, of those
+// in its electorate who did not vote, to their delegates who did, or
+// delegates of them and so on.
+func (s *sqlStore) proxies(electionID int64) ([]proxy, error) {
+	var endStr string
+	err := s.db.QueryRow(`SELECT "end_date" FROM "elections" WHERE "id" = $1`, electionID).Scan(&endStr)
+	if err != nil {
+		return nil, err
+	}
+	var end time.Time
+	err = end.UnmarshalText([]byte(endStr))
+	if err != nil {
+		return nil, err
+	}
+	groups, err := s.strings(`SELECT "group" FROM "election_groups" WHERE "election_id" = $1`, electionID)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := s.delegates(electionID, end, groups)
+	if err != nil {
+		return nil, err
+	}
+	if len(to) == 0 {
+		return nil, nil
+	}
+
+	names, err := s.strings(`SELECT "user" FROM "election_voted" WHERE "election_id" = $1`, electionID)
+	if err != nil {
+		return nil, err
+	}
+	voted := map[string]bool{}
+	for _, name := range names {
+		voted[name] = true
+	}
+
+	rows, err := s.db.Query(`SELECT "name", "weight" FROM "election_electorate" WHERE "election_id" = $1`, electionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	list := []proxy{}
+	for rows.Next() {
+		var (
+			name string
+			weight int64
+		)
+		err = rows.Scan(&name, &weight)
+		if err != nil {
+			return nil, err
+		}
+		if voted[name] {
+			continue
+		}
+		seen := map[string]bool{name: true}
+		for next, ok := to[name]; ok && !seen[next]; next, ok = to[next] {
+			if voted[next] {
+				list = append(list, proxy{delegate: next, weight: weight})
+				break
+			}
+			seen[next] = true
+		}
+	}
+	return list, rows.Err()
+}
+
+// delegates returns the delegate of each delegator in an election allowing
+// groups, as of end.
+func (s *sqlStore) delegates(electionID int64, end time.Time, groups []string) (map[string]string, error) {
+	rows, err := s.db.Query(`SELECT "delegator", "delegate", "election_id", "group", "created_at", "revoked_at" FROM "delegations"
+WHERE "election_id" = $1 OR "election_id" IS NULL ORDER BY "id"`, electionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	type candidate struct {
+		delegate	string
+		// higher takes precedence
+		rank	int
+	}
+	best := map[string]candidate{}
+	for rows.Next() {
+		var (
+			delegator, delegate, createdStr string
+			election sql.NullInt64
+			group, revokedStr sql.NullString
+			created, revoked time.Time
+		)
+		err = rows.Scan(&delegator, &delegate, &election, &group, &createdStr, &revokedStr)
+		if err != nil {
+			return nil, err
+		}
+		// dates are text, compare them here rather than in SQL
+		err = created.UnmarshalText([]byte(createdStr))
+		if err != nil {
+			return nil, err
+		}
+		if created.After(end) {
+			continue
+		}
+		if revokedStr.Valid {
+			err = revoked.UnmarshalText([]byte(revokedStr.String))
+			if err != nil {
+				return nil, err
+			}
+			if !revoked.After(end) {
+				continue
+			}
+		}
+
+		// for an election over those for any group, more specific groups
+		// over less, later ones over earlier
+		rank := -1
+		if election.Valid {
+			rank = 1 << 30
+		} else {
+			for _, g := range groups {
+				if g == group.String || strings.HasPrefix(g, group.String + "/") {
+					rank = strings.Count(group.String, "/")
+					break
+				}
+			}
+		}
+		if c, ok := best[delegator]; rank < 0 || ok && c.rank > rank {
+			continue
+		}
+		best[delegator] = candidate{delegate, rank}
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	to := map[string]string{}
+	for delegator, c := range best {
+		to[delegator] = c.delegate
+	}
+	return to, nil
+}
@@ -0,0 +1,69 @@
+package store
+
+import "database/sql"
+
+func (s *sqlStore) AddEvent(e Event) error {
+	_, err := s.db.Exec(`INSERT INTO "election_events" ("election_id", "kind", "at", "percent", "officer") VALUES ($1, $2, $3, $4, $5)`,
+		e.ElectionID, e.Kind, timeText(e.At), sql.NullInt64{Int64: int64(e.Percent), Valid: e.Kind == EventTurnout},
+		sql.NullString{String: e.Officer, Valid: e.Officer != ""})
+	return err
+}
+
+func (s *sqlStore) ListEvents(electionID, after int64) ([]Event, error) {
+	rows, err := s.db.Query(`SELECT "id", "kind", "at", "percent", "officer" FROM "election_events"
+WHERE "election_id" = $1 AND "id" > $2 ORDER BY "id"`, electionID, after)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	list := []Event{}
+	for rows.Next() {
+		var (
+			e Event
+			at string
+			percent sql.NullInt64
+			officer sql.NullString
+		)
+		err = rows.Scan(&e.ID, &e.Kind, &at, &percent, &officer)
+		if err != nil {
+			return nil, err
+		}
+		err = e.At.UnmarshalText([]byte(at))
+		if err != nil {
+			return nil, err
+		}
+		e.ElectionID, e.Percent, e.Officer = electionID, int(percent.Int64), officer.String
+		list = append(list, e)
+	}
+	return list, rows.Err()
+}
+
+func (s *sqlStore) UnclosedElections() ([]Election, error) {
+	rows, err := s.db.Query(`SELECT ` + electionColumns + ` FROM "elections" WHERE NOT EXISTS
+(SELECT 1 FROM "election_events" WHERE "election_id" = "elections"."id" AND "kind" IN ($1, $2)) ORDER BY "id"`, EventClosed, EventCancelled)
+	if err != nil {
+		return nil, err
+	}
+	list := []Election{}
+	for rows.Next() {
+		e, err := scanElection(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		list = append(list, e)
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range list {
+		err = s.fillElection(&list[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
@@ -0,0 +1,137 @@
+package store
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Migrations are named NNNN_description.sql and applied in order of NNNN,
+// one directory per backend. Both directories must stay in lockstep so that
+// schema versions mean the same thing everywhere.
+// Never edit a released migration, add a new one instead.
+//
+//go:embed migrations/*/*.sql
+var migrationsFS embed.FS
+
+const (
+	schemaVersionTable = `CREATE TABLE IF NOT EXISTS "schema_version" ("version" INTEGER PRIMARY KEY, "applied_at" TEXT)`
+)
+
+type migration struct {
+	version	int
+	name	string
+	sql	string
+}
+
+type SchemaTooNewError struct {
+	Current	int
+	Supported	int
+}
+
+func (e SchemaTooNewError) Error() string {
+	return fmt.Sprintf("database schema version %d is newer than supported version %d", e.Current, e.Supported)
+}
+
+var (
+	sqliteMigrations	= mustLoadMigrations(migrationsFS, "migrations/sqlite")
+	postgresMigrations	= mustLoadMigrations(migrationsFS, "migrations/postgres")
+)
+
+func init() {
+	if len(sqliteMigrations) != len(postgresMigrations) {
+		panic("sqlite and postgres migrations out of lockstep")
+	}
+}
+
+func mustLoadMigrations(fsys fs.FS, dir string) []migration {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		panic(err)
+	}
+	list := []migration{}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || path.Ext(name) != ".sql" {
+			continue
+		}
+		prefix, _, _ := strings.Cut(name, "_")
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			panic(fmt.Sprintf("malformed migration name %s", name))
+		}
+		bytes, err := fs.ReadFile(fsys, path.Join(dir, name))
+		if err != nil {
+			panic(err)
+		}
+		list = append(list, migration{version: version, name: name, sql: string(bytes)})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].version < list[j].version
+	})
+	for i, m := range list {
+		if m.version != i + 1 {
+			panic(fmt.Sprintf("migration %s/%s out of sequence", dir, m.name))
+		}
+	}
+	return list
+}
+
+// LatestSchemaVersion is the schema version this binary migrates to.
+func LatestSchemaVersion() int {
+	return len(sqliteMigrations)
+}
+
+// SchemaVersion returns the version of the schema in db, 0 if never migrated.
+func (s *sqlStore) SchemaVersion() (int, error) {
+	_, err := s.db.Exec(schemaVersionTable)
+	if err != nil {
+		return 0, err
+	}
+	var version sql.NullInt64
+	err = s.db.QueryRow(`SELECT MAX("version") FROM "schema_version"`).Scan(&version)
+	if err != nil {
+		return 0, err
+	}
+	return int(version.Int64), nil
+}
+
+// Migrate brings db up to LatestSchemaVersion, each migration in its own
+// transaction. It refuses to touch a db newer than this binary.
+func (s *sqlStore) Migrate() error {
+	current, err := s.SchemaVersion()
+	if err != nil {
+		return fmt.Errorf("cannot read schema version: %w", err)
+	}
+	if current > LatestSchemaVersion() {
+		return SchemaTooNewError{Current: current, Supported: LatestSchemaVersion()}
+	}
+
+	for _, m := range s.migrations[current:] {
+		tx, err := s.db.Begin()
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(m.sql)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %s: %w", m.name, err)
+		}
+		_, err = tx.Exec(`INSERT INTO "schema_version" ("version", "applied_at") VALUES ($1, $2)`, m.version, timeText(time.Now()))
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %s: %w", m.name, err)
+		}
+		err = tx.Commit()
+		if err != nil {
+			return fmt.Errorf("migration %s: %w", m.name, err)
+		}
+	}
+	return nil
+}
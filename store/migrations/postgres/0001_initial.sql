@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS "users" ("name" TEXT PRIMARY KEY, "group" TEXT);
+CREATE TABLE IF NOT EXISTS "keys" ("name" TEXT PRIMARY KEY, "key" BYTEA NOT NULL);
+CREATE TABLE IF NOT EXISTS "challenges" ("id" BIGSERIAL PRIMARY KEY, "name" TEXT, "value" TEXT);
+CREATE TABLE IF NOT EXISTS "elections" ("id" BIGSERIAL PRIMARY KEY, "name" TEXT UNIQUE, "end_date" TEXT);
+CREATE TABLE IF NOT EXISTS "election_groups" ("id" BIGSERIAL PRIMARY KEY, "election_id" BIGINT REFERENCES "elections"("id"), "group" TEXT);
+CREATE TABLE IF NOT EXISTS "election_choices" ("id" BIGSERIAL PRIMARY KEY, "election_id" BIGINT REFERENCES "elections"("id"), "choice" TEXT, "votes" INTEGER DEFAULT 0);
+CREATE TABLE IF NOT EXISTS "election_voted" ("id" BIGSERIAL PRIMARY KEY, "election_id" BIGINT REFERENCES "elections"("id"), "user" TEXT);
@@ -0,0 +1,3 @@
+-- Duplicates can only come from racing CastVote calls. Their votes stay counted.
+DELETE FROM "election_voted" WHERE "id" NOT IN (SELECT MIN("id") FROM "election_voted" GROUP BY "election_id", "user");
+CREATE UNIQUE INDEX IF NOT EXISTS "election_voted_election_user" ON "election_voted" ("election_id", "user");
@@ -0,0 +1 @@
+CREATE TABLE IF NOT EXISTS "election_roll" ("id" BIGSERIAL PRIMARY KEY, "election_id" BIGINT NOT NULL REFERENCES "elections"("id"), "name" TEXT NOT NULL, "exclude" BOOLEAN NOT NULL DEFAULT FALSE, UNIQUE ("election_id", "name"));
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS "election_electorate" ("id" BIGSERIAL PRIMARY KEY, "election_id" BIGINT NOT NULL REFERENCES "elections"("id"), "name" TEXT NOT NULL, UNIQUE ("election_id", "name"));
+ALTER TABLE "elections" ADD COLUMN "snapshot_date" TEXT;
+-- Freeze running elections with whoever is eligible right now.
+INSERT INTO "election_electorate" ("election_id", "name")
+SELECT "elections"."id", "users"."name" FROM "elections", "users"
+WHERE NOT EXISTS (SELECT 1 FROM "election_roll" AS "r" WHERE "r"."election_id" = "elections"."id" AND "r"."name" = "users"."name" AND "r"."exclude")
+AND (EXISTS (SELECT 1 FROM "election_roll" AS "r" WHERE "r"."election_id" = "elections"."id" AND "r"."name" = "users"."name" AND NOT "r"."exclude")
+OR EXISTS (SELECT 1 FROM "election_groups" AS "g" WHERE "g"."election_id" = "elections"."id" AND "g"."group" = "users"."group"));
+UPDATE "elections" SET "snapshot_date" = "start_date";
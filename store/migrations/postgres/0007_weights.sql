@@ -0,0 +1,5 @@
+ALTER TABLE "users" ADD COLUMN "weight" BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE "election_electorate" ADD COLUMN "weight" BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE "election_choices" ADD COLUMN "weighted_votes" BIGINT NOT NULL DEFAULT 0;
+UPDATE "election_choices" SET "weighted_votes" = "votes";
+CREATE TABLE IF NOT EXISTS "election_group_weights" ("id" BIGSERIAL PRIMARY KEY, "election_id" BIGINT NOT NULL REFERENCES "elections"("id"), "group" TEXT NOT NULL, "weight" BIGINT NOT NULL, UNIQUE ("election_id", "group"));
@@ -0,0 +1,3 @@
+-- Choices of ballots from before are unknown, and nobody delegated to them.
+ALTER TABLE "election_voted" ADD COLUMN "choice_id" BIGINT REFERENCES "election_choices"("id");
+CREATE TABLE IF NOT EXISTS "delegations" ("id" BIGSERIAL PRIMARY KEY, "delegator" TEXT NOT NULL, "delegate" TEXT NOT NULL, "election_id" BIGINT REFERENCES "elections"("id"), "group" TEXT, "created_at" TEXT NOT NULL, "revoked_at" TEXT);
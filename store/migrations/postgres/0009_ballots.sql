@@ -0,0 +1,11 @@
+ALTER TABLE "elections" ADD COLUMN "allow_revote" BOOLEAN NOT NULL DEFAULT FALSE;
+CREATE TABLE IF NOT EXISTS "ballots" ("id" BIGSERIAL PRIMARY KEY, "election_id" BIGINT NOT NULL REFERENCES "elections"("id"), "user" TEXT NOT NULL, "choice_id" BIGINT NOT NULL REFERENCES "election_choices"("id"), "weight" BIGINT NOT NULL, "cast_at" TEXT);
+CREATE INDEX IF NOT EXISTS "ballots_election_user" ON "ballots" ("election_id", "user");
+INSERT INTO "ballots" ("election_id", "user", "choice_id", "weight")
+SELECT "v"."election_id", "v"."user", "v"."choice_id", COALESCE("e"."weight", 1) FROM "election_voted" AS "v"
+LEFT JOIN "election_electorate" AS "e" ON "e"."election_id" = "v"."election_id" AND "e"."name" = "v"."user"
+WHERE "v"."choice_id" IS NOT NULL ORDER BY "v"."id";
+-- Counters are left with only ballots from before their choices were recorded.
+UPDATE "election_choices" SET
+"votes" = "votes" - (SELECT COUNT(*) FROM "ballots" WHERE "ballots"."choice_id" = "election_choices"."id"),
+"weighted_votes" = "weighted_votes" - (SELECT COALESCE(SUM("weight"), 0) FROM "ballots" WHERE "ballots"."choice_id" = "election_choices"."id");
@@ -0,0 +1,6 @@
+ALTER TABLE "election_voted" ADD COLUMN "abstain" BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE "elections" ADD COLUMN "quorum" INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE "elections" ADD COLUMN "threshold" INTEGER NOT NULL DEFAULT 0;
+-- Abstentions have no choice.
+ALTER TABLE "ballots" ALTER COLUMN "choice_id" DROP NOT NULL;
+ALTER TABLE "ballots" ADD COLUMN "abstain" BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,4 @@
+ALTER TABLE "election_questions" ADD COLUMN "allow_write_ins" BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE "ballot_answers" ADD COLUMN "write_in" TEXT;
+-- write-ins are counted as the choice they are adjudicated to, if any
+CREATE TABLE IF NOT EXISTS "write_in_adjudications" ("id" BIGSERIAL PRIMARY KEY, "question_id" BIGINT NOT NULL REFERENCES "election_questions"("id"), "write_in" TEXT NOT NULL, "choice" TEXT NOT NULL, UNIQUE ("question_id", "write_in"));
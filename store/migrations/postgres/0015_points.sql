@@ -0,0 +1,4 @@
+-- what each voter distributes for cumulative ballots
+ALTER TABLE "election_questions" ADD COLUMN "points" INTEGER NOT NULL DEFAULT 0;
+-- given to the choice for cumulative and Borda ballots, 0 otherwise
+ALTER TABLE "ballot_answers" ADD COLUMN "points" INTEGER NOT NULL DEFAULT 0;
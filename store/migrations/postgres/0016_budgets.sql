@@ -0,0 +1,5 @@
+-- for participatory budgeting, what funded choices may cost in total, and
+-- how they are selected
+ALTER TABLE "election_questions" ADD COLUMN "budget" BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE "election_questions" ADD COLUMN "funding" INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE "election_choices" ADD COLUMN "cost" BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,6 @@
+-- seeds of random beacons breaking ties, committed to by their SHA-256, in
+-- hex, and unset for elections from before
+ALTER TABLE "elections" ADD COLUMN "seed" TEXT;
+ALTER TABLE "elections" ADD COLUMN "commitment" TEXT;
+-- contributions mixed into the beacon, in hex, unset until contributed
+CREATE TABLE IF NOT EXISTS "election_trustees" ("id" BIGSERIAL PRIMARY KEY, "election_id" BIGINT NOT NULL REFERENCES "elections"("id"), "name" TEXT NOT NULL, "contribution" TEXT, UNIQUE ("election_id", "name"));
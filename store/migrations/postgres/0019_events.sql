@@ -0,0 +1,3 @@
+-- what happened to elections, in order of id, for watchers to resume from
+CREATE TABLE IF NOT EXISTS "election_events" ("id" BIGSERIAL PRIMARY KEY, "election_id" BIGINT NOT NULL REFERENCES "elections"("id"), "kind" INTEGER NOT NULL, "at" TEXT NOT NULL, "percent" INTEGER, "officer" TEXT);
+CREATE INDEX IF NOT EXISTS "election_events_election" ON "election_events" ("election_id", "id");
@@ -0,0 +1,3 @@
+-- when no nominee qualified for the first question, which takes no write-ins,
+-- leaving nothing to vote for
+ALTER TABLE "elections" ADD COLUMN "cancelled" BOOLEAN NOT NULL DEFAULT FALSE;
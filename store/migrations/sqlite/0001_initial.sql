@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS 'users' ('name' TEXT PRIMARY KEY, 'group' TEXT);
+CREATE TABLE IF NOT EXISTS 'challenges' ('id' INTEGER PRIMARY KEY AUTOINCREMENT, 'name' TEXT, 'value' TEXT);
+CREATE TABLE IF NOT EXISTS 'elections' ('id' INTEGER PRIMARY KEY AUTOINCREMENT, 'name' TEXT UNIQUE, 'end_date' TEXT);
+CREATE TABLE IF NOT EXISTS 'election_groups' ('id' INTEGER PRIMARY KEY AUTOINCREMENT, 'election_id' INTEGER, 'group' TEXT, FOREIGN KEY('election_id') REFERENCES elections('id'));
+CREATE TABLE IF NOT EXISTS 'election_choices' ('id' INTEGER PRIMARY KEY AUTOINCREMENT, 'election_id' INTEGER, 'choice' TEXT, 'votes' INTEGER DEFAULT 0, FOREIGN KEY('election_id') REFERENCES elections('id'));
+CREATE TABLE IF NOT EXISTS 'election_voted' ('id' INTEGER PRIMARY KEY AUTOINCREMENT, 'election_id' INTEGER, 'user' TEXT, FOREIGN KEY('election_id') REFERENCES elections('id'));
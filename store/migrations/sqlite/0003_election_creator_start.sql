@@ -0,0 +1,2 @@
+ALTER TABLE "elections" ADD COLUMN "creator" TEXT;
+ALTER TABLE "elections" ADD COLUMN "start_date" TEXT;
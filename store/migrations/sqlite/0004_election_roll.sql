@@ -0,0 +1 @@
+CREATE TABLE IF NOT EXISTS "election_roll" ("id" INTEGER PRIMARY KEY AUTOINCREMENT, "election_id" INTEGER NOT NULL REFERENCES "elections"("id"), "name" TEXT NOT NULL, "exclude" BOOLEAN NOT NULL DEFAULT FALSE, UNIQUE ("election_id", "name"));
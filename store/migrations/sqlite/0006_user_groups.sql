@@ -0,0 +1,3 @@
+-- Supersedes users.group, which is no longer maintained.
+CREATE TABLE IF NOT EXISTS "user_groups" ("id" INTEGER PRIMARY KEY AUTOINCREMENT, "name" TEXT NOT NULL REFERENCES "users"("name"), "group" TEXT NOT NULL, UNIQUE ("name", "group"));
+INSERT INTO "user_groups" ("name", "group") SELECT "name", "group" FROM "users" WHERE "group" IS NOT NULL;
@@ -0,0 +1,5 @@
+ALTER TABLE "users" ADD COLUMN "weight" INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE "election_electorate" ADD COLUMN "weight" INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE "election_choices" ADD COLUMN "weighted_votes" INTEGER NOT NULL DEFAULT 0;
+UPDATE "election_choices" SET "weighted_votes" = "votes";
+CREATE TABLE IF NOT EXISTS "election_group_weights" ("id" INTEGER PRIMARY KEY AUTOINCREMENT, "election_id" INTEGER NOT NULL REFERENCES "elections"("id"), "group" TEXT NOT NULL, "weight" INTEGER NOT NULL, UNIQUE ("election_id", "group"));
@@ -0,0 +1,3 @@
+-- Choices of ballots from before are unknown, and nobody delegated to them.
+ALTER TABLE "election_voted" ADD COLUMN "choice_id" INTEGER REFERENCES "election_choices"("id");
+CREATE TABLE IF NOT EXISTS "delegations" ("id" INTEGER PRIMARY KEY AUTOINCREMENT, "delegator" TEXT NOT NULL, "delegate" TEXT NOT NULL, "election_id" INTEGER REFERENCES "elections"("id"), "group" TEXT, "created_at" TEXT NOT NULL, "revoked_at" TEXT);
@@ -0,0 +1,10 @@
+ALTER TABLE "election_voted" ADD COLUMN "abstain" BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE "elections" ADD COLUMN "quorum" INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE "elections" ADD COLUMN "threshold" INTEGER NOT NULL DEFAULT 0;
+-- Abstentions have no choice, but SQLite can't drop NOT NULL. Rebuild.
+CREATE TABLE "ballots_new" ("id" INTEGER PRIMARY KEY AUTOINCREMENT, "election_id" INTEGER NOT NULL REFERENCES "elections"("id"), "user" TEXT NOT NULL, "choice_id" INTEGER REFERENCES "election_choices"("id"), "weight" INTEGER NOT NULL, "cast_at" TEXT, "abstain" BOOLEAN NOT NULL DEFAULT FALSE);
+INSERT INTO "ballots_new" ("id", "election_id", "user", "choice_id", "weight", "cast_at")
+SELECT "id", "election_id", "user", "choice_id", "weight", "cast_at" FROM "ballots";
+DROP TABLE "ballots";
+ALTER TABLE "ballots_new" RENAME TO "ballots";
+CREATE INDEX IF NOT EXISTS "ballots_election_user" ON "ballots" ("election_id", "user");
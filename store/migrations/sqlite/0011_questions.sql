@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS "election_questions" ("id" INTEGER PRIMARY KEY AUTOINCREMENT, "election_id" INTEGER NOT NULL REFERENCES "elections"("id"), "position" INTEGER NOT NULL, "title" TEXT NOT NULL, "type" INTEGER NOT NULL DEFAULT 0, "threshold" INTEGER NOT NULL DEFAULT 0, UNIQUE ("election_id", "position"));
+CREATE TABLE IF NOT EXISTS "ballot_answers" ("id" INTEGER PRIMARY KEY AUTOINCREMENT, "ballot_id" INTEGER NOT NULL REFERENCES "ballots"("id"), "question_id" INTEGER NOT NULL REFERENCES "election_questions"("id"), "choice_id" INTEGER REFERENCES "election_choices"("id"), "abstain" BOOLEAN NOT NULL DEFAULT FALSE);
+CREATE INDEX IF NOT EXISTS "ballot_answers_ballot" ON "ballot_answers" ("ballot_id");
+ALTER TABLE "election_choices" ADD COLUMN "question_id" INTEGER REFERENCES "election_questions"("id");
+-- Elections so far have a single untitled question. This supersedes
+-- elections.threshold, ballots.choice_id, ballots.abstain,
+-- election_voted.choice_id and election_voted.abstain.
+INSERT INTO "election_questions" ("election_id", "position", "title", "threshold") SELECT "id", 0, '', "threshold" FROM "elections";
+UPDATE "election_choices" SET "question_id" = (SELECT "id" FROM "election_questions" AS "q" WHERE "q"."election_id" = "election_choices"."election_id");
+INSERT INTO "ballot_answers" ("ballot_id", "question_id", "choice_id", "abstain")
+SELECT "b"."id", "q"."id", "b"."choice_id", "b"."abstain" FROM "ballots" AS "b" JOIN "election_questions" AS "q" ON "q"."election_id" = "b"."election_id" ORDER BY "b"."id";
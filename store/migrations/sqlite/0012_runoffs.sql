@@ -0,0 +1,4 @@
+ALTER TABLE "elections" ADD COLUMN "runoff_seconds" INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE "elections" ADD COLUMN "runoff_id" INTEGER REFERENCES "elections"("id");
+-- whether the need for a runoff has been decided, after the end
+ALTER TABLE "elections" ADD COLUMN "runoff_settled" BOOLEAN NOT NULL DEFAULT FALSE;
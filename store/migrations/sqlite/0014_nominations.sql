@@ -0,0 +1,8 @@
+ALTER TABLE "elections" ADD COLUMN "nominations_end" TEXT;
+ALTER TABLE "elections" ADD COLUMN "seconds_required" INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE "elections" ADD COLUMN "acceptance_required" BOOLEAN NOT NULL DEFAULT FALSE;
+-- whether qualified nominees have been added as choices, after nominations_end
+ALTER TABLE "elections" ADD COLUMN "nominations_settled" BOOLEAN NOT NULL DEFAULT FALSE;
+-- accepted is NULL until the nominee responds
+CREATE TABLE IF NOT EXISTS "nominations" ("id" INTEGER PRIMARY KEY AUTOINCREMENT, "election_id" INTEGER NOT NULL REFERENCES "elections"("id"), "nominee" TEXT NOT NULL, "nominator" TEXT NOT NULL, "accepted" BOOLEAN, "created_at" TEXT NOT NULL, UNIQUE ("election_id", "nominee"));
+CREATE TABLE IF NOT EXISTS "nomination_seconds" ("id" INTEGER PRIMARY KEY AUTOINCREMENT, "nomination_id" INTEGER NOT NULL REFERENCES "nominations"("id"), "user" TEXT NOT NULL, UNIQUE ("nomination_id", "user"));
@@ -0,0 +1,2 @@
+-- countersignatures of result documents, in hex, unset until certified
+CREATE TABLE IF NOT EXISTS "election_officers" ("id" INTEGER PRIMARY KEY AUTOINCREMENT, "election_id" INTEGER NOT NULL REFERENCES "elections"("id"), "name" TEXT NOT NULL, "signature" TEXT, UNIQUE ("election_id", "name"));
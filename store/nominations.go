@@ -0,0 +1,215 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// checkElectorate returns nil if user is in the electorate of an election,
+// or why not otherwise.
+func (s *sqlStore) checkElectorate(tx *sql.Tx, electionID int64, user string) error {
+	var n int
+	err := tx.QueryRow(`SELECT COUNT(*) FROM "election_electorate" WHERE "election_id" = $1 AND "name" = $2`, electionID, user).Scan(&n)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return s.whyNotEligible(tx, electionID, user)
+	}
+	return nil
+}
+
+func (s *sqlStore) Nominate(electionID int64, nominator, nominee string, at time.Time) error {
+	return s.serializable(func(tx *sql.Tx) error {
+		err := s.checkElectorate(tx, electionID, nominator)
+		if err != nil {
+			return err
+		}
+		var n int
+		err = tx.QueryRow(`SELECT COUNT(*) FROM "users" WHERE "name" = $1`, nominee).Scan(&n)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrVoterNotFound
+		}
+
+		_, err = tx.Exec(`INSERT INTO "nominations" ("election_id", "nominee", "nominator", "created_at") VALUES ($1, $2, $3, $4)`,
+			electionID, nominee, nominator, timeText(at))
+		if s.isConflict(err) {
+			return ErrExists
+		}
+		return err
+	})
+}
+
+func (s *sqlStore) Second(electionID int64, user, nominee string) error {
+	return s.serializable(func(tx *sql.Tx) error {
+		err := s.checkElectorate(tx, electionID, user)
+		if err != nil {
+			return err
+		}
+		var (
+			id int64
+			nominator string
+		)
+		err = tx.QueryRow(`SELECT "id", "nominator" FROM "nominations" WHERE "election_id" = $1 AND "nominee" = $2`, electionID, nominee).
+			Scan(&id, &nominator)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if user == nominator || user == nominee {
+			return ErrOwnNomination
+		}
+
+		_, err = tx.Exec(`INSERT INTO "nomination_seconds" ("nomination_id", "user") VALUES ($1, $2)`, id, user)
+		if s.isConflict(err) {
+			return ErrExists
+		}
+		return err
+	})
+}
+
+func (s *sqlStore) RespondNomination(electionID int64, nominee string, accept bool) error {
+	res, err := s.db.Exec(`UPDATE "nominations" SET "accepted" = $3 WHERE "election_id" = $1 AND "nominee" = $2`, electionID, nominee, accept)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *sqlStore) ListNominations(electionID int64) ([]Nomination, error) {
+	return listNominations(s.db, electionID)
+}
+
+// querier is what *sql.DB and *sql.Tx have in common.
+type querier interface {
+	Query(query string, args ...any) (*sql.Rows, error)
+}
+
+func listNominations(q querier, electionID int64) ([]Nomination, error) {
+	rows, err := q.Query(`SELECT "n"."nominee", "n"."nominator", "n"."accepted", "e"."seconds_required", "e"."acceptance_required",
+(SELECT COUNT(*) FROM "nomination_seconds" WHERE "nomination_id" = "n"."id")
+FROM "nominations" AS "n" JOIN "elections" AS "e" ON "e"."id" = "n"."election_id" WHERE "n"."election_id" = $1 ORDER BY "n"."id"`, electionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	list := []Nomination{}
+	for rows.Next() {
+		var (
+			n Nomination
+			accepted sql.NullBool
+			secondsRequired int
+			acceptanceRequired bool
+		)
+		err = rows.Scan(&n.Nominee, &n.Nominator, &accepted, &secondsRequired, &acceptanceRequired, &n.Seconds)
+		if err != nil {
+			return nil, err
+		}
+		n.Accepted = accepted.Valid && accepted.Bool
+		n.Declined = accepted.Valid && !accepted.Bool
+		n.Qualified = n.Seconds >= secondsRequired && !n.Declined && (n.Accepted || !acceptanceRequired)
+		list = append(list, n)
+	}
+	return list, rows.Err()
+}
+
+func (s *sqlStore) PendingNominations(at time.Time) ([]Election, error) {
+	rows, err := s.db.Query(`SELECT ` + electionColumns + ` FROM "elections" WHERE "nominations_end" IS NOT NULL AND NOT "nominations_settled" ORDER BY "id"`)
+	if err != nil {
+		return nil, err
+	}
+	list := []Election{}
+	for rows.Next() {
+		e, err := scanElection(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		// dates are text, compare them here rather than in SQL
+		if at.Before(e.NominationsEnd) {
+			continue
+		}
+		list = append(list, e)
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range list {
+		err = s.fillElection(&list[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+func (s *sqlStore) SettleNominations(electionID int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	res, err := tx.Exec(`UPDATE "elections" SET "nominations_settled" = TRUE WHERE "id" = $1 AND NOT "nominations_settled"`, electionID)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+
+	nominations, err := listNominations(tx, electionID)
+	if err != nil {
+		return err
+	}
+	var (
+		questionID int64
+		writeIns bool
+	)
+	err = tx.QueryRow(`SELECT "id", "allow_write_ins" FROM "election_questions" WHERE "election_id" = $1 AND "position" = 0`, electionID).
+		Scan(&questionID, &writeIns)
+	if err != nil {
+		return err
+	}
+	for _, n := range nominations {
+		if !n.Qualified {
+			continue
+		}
+		var exists int
+		err = tx.QueryRow(`SELECT COUNT(*) FROM "election_choices" WHERE "question_id" = $1 AND "choice" = $2`, questionID, n.Nominee).Scan(&exists)
+		if err != nil {
+			return err
+		}
+		if exists > 0 {
+			continue
+		}
+		_, err = tx.Exec(`INSERT INTO "election_choices" ("election_id", "question_id", "choice") VALUES ($1, $2, $3)`, electionID, questionID, n.Nominee)
+		if err != nil {
+			return err
+		}
+	}
+
+	var choices int
+	err = tx.QueryRow(`SELECT COUNT(*) FROM "election_choices" WHERE "question_id" = $1`, questionID).Scan(&choices)
+	if err != nil {
+		return err
+	}
+	if choices == 0 && !writeIns {
+		_, err = tx.Exec(`UPDATE "elections" SET "cancelled" = TRUE WHERE "id" = $1`, electionID)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
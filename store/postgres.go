@@ -0,0 +1,87 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+)
+
+// Postgres keeps everything including voter keys in the database. It is not
+// Replicable, deployments are expected to use PostgreSQL replication and
+// point every node at it.
+type Postgres struct {
+	sqlStore
+}
+
+func OpenPostgres(dsn string) (*Postgres, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &Postgres{
+		sqlStore: sqlStore{
+			db: db,
+			migrations: postgresMigrations,
+			isConflict: postgresIsConflict,
+			isRetryable: postgresIsRetryable,
+		},
+	}, nil
+}
+
+func postgresIsConflict(err error) bool {
+	var e *pq.Error
+	return errors.As(err, &e) && e.Code.Class() == "23"
+}
+
+func postgresIsRetryable(err error) bool {
+	var e *pq.Error
+	// serialization_failure, deadlock_detected
+	return errors.As(err, &e) && (e.Code == "40001" || e.Code == "40P01")
+}
+
+func (s *Postgres) PutKey(name string, key []byte) error {
+	_, err := s.db.Exec(`INSERT INTO "keys" ("name", "key") VALUES ($1, $2) ON CONFLICT ("name") DO UPDATE SET "key" = EXCLUDED."key"`, name, key)
+	return err
+}
+
+func (s *Postgres) GetKey(name string) ([]byte, error) {
+	var key []byte
+	err := s.db.QueryRow(`SELECT "key" FROM "keys" WHERE "name" = $1`, name).Scan(&key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return key, err
+}
+
+func (s *Postgres) RemoveKey(name string) error {
+	res, err := s.db.Exec(`DELETE FROM "keys" WHERE "name" = $1`, name)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *Postgres) Keys() (map[string][]byte, error) {
+	rows, err := s.db.Query(`SELECT "name", "key" FROM "keys"`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	keys := map[string][]byte{}
+	for rows.Next() {
+		var (
+			name string
+			key []byte
+		)
+		err = rows.Scan(&name, &key)
+		if err != nil {
+			return nil, err
+		}
+		keys[name] = key
+	}
+	return keys, rows.Err()
+}
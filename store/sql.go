@@ -0,0 +1,1328 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// sqlStore implements everything but keys on top of database/sql, in the
+// SQL subset shared by SQLite and PostgreSQL.
+type sqlStore struct {
+	db	*sql.DB
+	migrations	[]migration
+	// unique or foreign key constraint violated
+	isConflict	func(error) bool
+	// transaction lost a race and should be retried
+	isRetryable	func(error) bool
+}
+
+const (
+	maxRetries = 8
+)
+
+func (s *sqlStore) Close() error {
+	return s.db.Close()
+}
+
+// serializable runs f in a serializable transaction, retrying it from
+// scratch if it loses a race with another one. Errors from f abort it.
+func (s *sqlStore) serializable(f func(*sql.Tx) error) error {
+	for i := 0; i < maxRetries; i++ {
+		tx, err := s.db.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelSerializable})
+		if err != nil {
+			if s.isRetryable(err) {
+				continue
+			}
+			return err
+		}
+		err = f(tx)
+		if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+		if err == nil || !s.isRetryable(err) {
+			return err
+		}
+	}
+	return ErrConflict
+}
+
+func (s *sqlStore) AddVoter(v Voter) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = s.addVoter(tx, v)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *sqlStore) addVoter(tx *sql.Tx, v Voter) error {
+	weight := v.Weight
+	if weight == 0 {
+		weight = 1
+	}
+	_, err := tx.Exec(`INSERT INTO "users" ("name", "weight") VALUES ($1, $2)`, v.Name, weight)
+	if err != nil {
+		if s.isConflict(err) {
+			return ErrExists
+		}
+		return err
+	}
+	for _, g := range v.Groups {
+		_, err = tx.Exec(`INSERT INTO "user_groups" ("name", "group") VALUES ($1, $2) ON CONFLICT DO NOTHING`, v.Name, g)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sqlStore) AddVoters(voters []Voter, dryRun bool) ([]error, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	errs := make([]error, len(voters))
+	failed := false
+	seen := map[string]bool{}
+	for i, v := range voters {
+		// check first, a failed statement aborts the whole transaction on
+		// PostgreSQL
+		var n int
+		err = tx.QueryRow(`SELECT COUNT(*) FROM "users" WHERE "name" = $1`, v.Name).Scan(&n)
+		if err != nil {
+			return nil, err
+		}
+		if n > 0 || seen[v.Name] {
+			errs[i] = ErrExists
+			failed = true
+			continue
+		}
+		seen[v.Name] = true
+
+		err = s.addVoter(tx, v)
+		if errors.Is(err, ErrExists) {
+			// raced with another registration
+			return nil, ErrConflict
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if failed || dryRun {
+		return errs, nil
+	}
+	return errs, tx.Commit()
+}
+
+func (s *sqlStore) GetVoter(name string) (Voter, error) {
+	v := Voter{Name: name}
+	err := s.db.QueryRow(`SELECT "weight" FROM "users" WHERE "name" = $1`, name).Scan(&v.Weight)
+	if errors.Is(err, sql.ErrNoRows) {
+		return v, ErrNotFound
+	}
+	if err != nil {
+		return v, err
+	}
+	v.Groups, err = s.strings(`SELECT "group" FROM "user_groups" WHERE "name" = $1 ORDER BY "id"`, name)
+	return v, err
+}
+
+func (s *sqlStore) RemoveVoter(name string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`DELETE FROM "user_groups" WHERE "name" = $1`, name)
+	if err != nil {
+		return err
+	}
+	res, err := tx.Exec(`DELETE FROM "users" WHERE "name" = $1`, name)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return tx.Commit()
+}
+
+func (s *sqlStore) AddMembership(name, group string) error {
+	// no foreign keys on SQLite, check by hand
+	res, err := s.db.Exec(`INSERT INTO "user_groups" ("name", "group") SELECT "name", $2 FROM "users" WHERE "name" = $1`, name, group)
+	if err != nil {
+		if s.isConflict(err) {
+			return ErrExists
+		}
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *sqlStore) RemoveMembership(name, group string) error {
+	res, err := s.db.Exec(`DELETE FROM "user_groups" WHERE "name" = $1 AND "group" = $2`, name, group)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *sqlStore) UpdateVoter(name string, groups []string, weight int64) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var n int
+	err = tx.QueryRow(`SELECT COUNT(*) FROM "users" WHERE "name" = $1`, name).Scan(&n)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	if len(groups) > 0 {
+		_, err = tx.Exec(`DELETE FROM "user_groups" WHERE "name" = $1`, name)
+		if err != nil {
+			return err
+		}
+	}
+	for _, g := range groups {
+		_, err = tx.Exec(`INSERT INTO "user_groups" ("name", "group") VALUES ($1, $2) ON CONFLICT DO NOTHING`, name, g)
+		if err != nil {
+			return err
+		}
+	}
+	if weight != 0 {
+		_, err = tx.Exec(`UPDATE "users" SET "weight" = $1 WHERE "name" = $2`, weight, name)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *sqlStore) ListVoters(f VoterFilter) ([]Voter, error) {
+	query := `SELECT "name", "weight" FROM "users" WHERE "name" > $1`
+	args := []any{f.After}
+	if f.Group != "" {
+		args = append(args, f.Group)
+		query += fmt.Sprintf(` AND EXISTS (SELECT 1 FROM "user_groups" AS "m" WHERE "m"."name" = "users"."name" AND %s)`,
+			groupCondition(`"m"."group"`, fmt.Sprintf("CAST($%d AS TEXT)", len(args))))
+	}
+	query += ` ORDER BY "name"`
+	if f.Limit > 0 {
+		query += fmt.Sprintf(` LIMIT %d`, f.Limit)
+	}
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	list := []Voter{}
+	for rows.Next() {
+		var v Voter
+		err = rows.Scan(&v.Name, &v.Weight)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		list = append(list, v)
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range list {
+		list[i].Groups, err = s.strings(`SELECT "group" FROM "user_groups" WHERE "name" = $1 ORDER BY "id"`, list[i].Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+func (s *sqlStore) AddChallenge(name, value string) error {
+	_, err := s.db.Exec(`INSERT INTO "challenges" ("name", "value") VALUES ($1, $2)`, name, value)
+	return err
+}
+
+func (s *sqlStore) Challenges(name string) ([]string, error) {
+	return s.strings(`SELECT "value" FROM "challenges" WHERE "name" = $1`, name)
+}
+
+func (s *sqlStore) RemoveChallenge(value string) error {
+	_, err := s.db.Exec(`DELETE FROM "challenges" WHERE "value" = $1`, value)
+	return err
+}
+
+func (s *sqlStore) CreateElection(e Election) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	_, err = s.createElection(tx, e)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *sqlStore) createElection(tx *sql.Tx, e Election) (int64, error) {
+	var id int64
+	nominationsEnd := sql.NullString{String: timeText(e.NominationsEnd), Valid: !e.NominationsEnd.IsZero()}
+	err := tx.QueryRow(`INSERT INTO "elections" ("name", "start_date", "end_date", "creator", "allow_revote", "quorum", "runoff_seconds", "nominations_end", "seconds_required", "acceptance_required",
+"seed", "commitment") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING "id"`,
+		e.Name, timeText(e.StartDate), timeText(e.EndDate), e.Creator, e.AllowRevote, e.Quorum, int64(e.Runoff / time.Second),
+		nominationsEnd, e.SecondsRequired, e.AcceptanceRequired, hexText(e.Seed), hexText(e.Commitment)).Scan(&id)
+	if err != nil {
+		if s.isConflict(err) {
+			return 0, ErrExists
+		}
+		return 0, err
+	}
+
+	for _, g := range e.Groups {
+		_, err = tx.Exec(`INSERT INTO "election_groups" ("election_id", "group") VALUES ($1, $2)`, id, g)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	trustees := map[string]bool{}
+	for _, t := range e.Trustees {
+		if trustees[t.Name] {
+			continue
+		}
+		trustees[t.Name] = true
+		_, err = tx.Exec(`INSERT INTO "election_trustees" ("election_id", "name") VALUES ($1, $2)`, id, t.Name)
+		if err != nil {
+			return 0, err
+		}
+	}
+	officers := map[string]bool{}
+	for _, o := range e.Officers {
+		if officers[o.Name] {
+			continue
+		}
+		officers[o.Name] = true
+		_, err = tx.Exec(`INSERT INTO "election_officers" ("election_id", "name") VALUES ($1, $2)`, id, o.Name)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	for i, q := range e.Questions {
+		var questionID int64
+		err = tx.QueryRow(`INSERT INTO "election_questions" ("election_id", "position", "title", "type", "threshold", "allow_write_ins", "points", "budget", "funding")
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING "id"`,
+			id, i, q.Title, q.Type, q.Threshold, q.AllowWriteIns, q.Points, q.Budget, q.Funding).Scan(&questionID)
+		if err != nil {
+			return 0, err
+		}
+		for j, c := range q.Choices {
+			var cost int64
+			if j < len(q.Costs) {
+				cost = q.Costs[j]
+			}
+			_, err = tx.Exec(`INSERT INTO "election_choices" ("election_id", "question_id", "choice", "cost") VALUES ($1, $2, $3, $4)`, id, questionID, c, cost)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	for _, w := range e.GroupWeights {
+		_, err = tx.Exec(`INSERT INTO "election_group_weights" ("election_id", "group", "weight") VALUES ($1, $2, $3)`, id, w.Group, w.Weight)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = updateRoll(tx, id, e.Roll, nil)
+	if err != nil {
+		return 0, err
+	}
+	return id, snapshot(tx, id, e.StartDate)
+}
+
+func snapshot(tx *sql.Tx, electionID int64, at time.Time) error {
+	_, err := tx.Exec(`DELETE FROM "election_electorate" WHERE "election_id" = $1`, electionID)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`INSERT INTO "election_electorate" ("election_id", "name", "weight")
+SELECT "elections"."id", "users"."name", ` + weightExpression(`"elections"."id"`, `"users"."name"`, `"users"."weight"`) +
+		` FROM "elections", "users" WHERE "elections"."id" = $1 AND ` + eligibleCondition(`"elections"."id"`, `"users"."name"`), electionID)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`UPDATE "elections" SET "snapshot_date" = $1 WHERE "id" = $2`, timeText(at), electionID)
+	return err
+}
+
+func (s *sqlStore) Snapshot(electionID int64, at time.Time) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	err = snapshot(tx, electionID, at)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func updateRoll(tx *sql.Tx, electionID int64, add []RollEntry, remove []string) error {
+	for _, r := range add {
+		_, err := tx.Exec(`INSERT INTO "election_roll" ("election_id", "name", "exclude") VALUES ($1, $2, $3)
+ON CONFLICT ("election_id", "name") DO UPDATE SET "exclude" = EXCLUDED."exclude"`, electionID, r.Name, r.Exclude)
+		if err != nil {
+			return err
+		}
+	}
+	for _, name := range remove {
+		_, err := tx.Exec(`DELETE FROM "election_roll" WHERE "election_id" = $1 AND "name" = $2`, electionID, name)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sqlStore) UpdateRoll(electionID int64, add []RollEntry, remove []string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	err = updateRoll(tx, electionID, add, remove)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+const (
+	electionColumns = `"id", "name", "start_date", "end_date", "creator", "snapshot_date", "allow_revote", "quorum", "runoff_seconds",
+"nominations_end", "seconds_required", "acceptance_required", "seed", "commitment", "cancelled"`
+)
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanElection scans electionColumns, without groups and questions.
+func scanElection(row scanner) (Election, error) {
+	var (
+		e Election
+		startStr, creator, snapshotStr, nominationsStr, seed, commitment sql.NullString
+		endStr string
+		runoff int64
+	)
+	err := row.Scan(&e.ID, &e.Name, &startStr, &endStr, &creator, &snapshotStr, &e.AllowRevote, &e.Quorum, &runoff,
+		&nominationsStr, &e.SecondsRequired, &e.AcceptanceRequired, &seed, &commitment, &e.Cancelled)
+	if err != nil {
+		return e, err
+	}
+	e.Seed, err = hexBytes(seed)
+	if err != nil {
+		return e, err
+	}
+	e.Commitment, err = hexBytes(commitment)
+	if err != nil {
+		return e, err
+	}
+	e.Runoff = time.Duration(runoff) * time.Second
+	e.Creator = creator.String
+	if startStr.Valid {
+		err = e.StartDate.UnmarshalText([]byte(startStr.String))
+		if err != nil {
+			return e, err
+		}
+	}
+	if snapshotStr.Valid {
+		err = e.SnapshotDate.UnmarshalText([]byte(snapshotStr.String))
+		if err != nil {
+			return e, err
+		}
+	}
+	if nominationsStr.Valid {
+		err = e.NominationsEnd.UnmarshalText([]byte(nominationsStr.String))
+		if err != nil {
+			return e, err
+		}
+	}
+	err = e.EndDate.UnmarshalText([]byte(endStr))
+	return e, err
+}
+
+func (s *sqlStore) fillElection(e *Election) error {
+	var err error
+	e.Groups, err = s.strings(`SELECT "group" FROM "election_groups" WHERE "election_id" = $1 ORDER BY "id"`, e.ID)
+	if err != nil {
+		return err
+	}
+	e.Questions, err = s.questions(e.ID)
+	if err != nil {
+		return err
+	}
+	runoffs, err := s.strings(`SELECT "name" FROM "elections" WHERE "id" = (SELECT "runoff_id" FROM "elections" WHERE "id" = $1)`, e.ID)
+	if err != nil {
+		return err
+	}
+	if len(runoffs) > 0 {
+		e.RunoffElection = runoffs[0]
+	}
+	firsts, err := s.strings(`SELECT "name" FROM "elections" WHERE "runoff_id" = $1`, e.ID)
+	if err != nil {
+		return err
+	}
+	if len(firsts) > 0 {
+		e.FirstRound = firsts[0]
+	}
+
+	err = s.db.QueryRow(`SELECT COUNT(*), COALESCE(SUM("weight"), 0) FROM "election_electorate" WHERE "election_id" = $1`, e.ID).
+		Scan(&e.Electorate, &e.ElectorateWeight)
+	if err != nil {
+		return err
+	}
+
+	rows, err := s.db.Query(`SELECT "group", "weight" FROM "election_group_weights" WHERE "election_id" = $1 ORDER BY "id"`, e.ID)
+	if err != nil {
+		return err
+	}
+	e.GroupWeights = []GroupWeight{}
+	for rows.Next() {
+		var w GroupWeight
+		err = rows.Scan(&w.Group, &w.Weight)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+		e.GroupWeights = append(e.GroupWeights, w)
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return err
+	}
+
+	e.Trustees, err = s.trustees(e.ID)
+	if err != nil {
+		return err
+	}
+	e.Officers, err = s.officers(e.ID)
+	if err != nil {
+		return err
+	}
+
+	rows, err = s.db.Query(`SELECT "name", "exclude" FROM "election_roll" WHERE "election_id" = $1 ORDER BY "id"`, e.ID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	e.Roll = []RollEntry{}
+	for rows.Next() {
+		var r RollEntry
+		err = rows.Scan(&r.Name, &r.Exclude)
+		if err != nil {
+			return err
+		}
+		e.Roll = append(e.Roll, r)
+	}
+	return rows.Err()
+}
+
+// eligibleCondition is an SQL condition that voter named by SQL expression
+// voter is eligible by the groups and roll of the election with ID election.
+// The roll takes precedence over groups. Members of subgroups of an allowed
+// group are eligible too.
+func eligibleCondition(election, voter string) string {
+	return fmt.Sprintf(`NOT EXISTS (SELECT 1 FROM "election_roll" AS "r" WHERE "r"."election_id" = %[1]s AND "r"."name" = %[2]s AND "r"."exclude")
+AND (EXISTS (SELECT 1 FROM "election_roll" AS "r" WHERE "r"."election_id" = %[1]s AND "r"."name" = %[2]s AND NOT "r"."exclude")
+OR EXISTS (SELECT 1 FROM "election_groups" AS "g" JOIN "user_groups" AS "m" ON %[3]s
+	WHERE "g"."election_id" = %[1]s AND "m"."name" = %[2]s))`, election, voter, groupCondition(`"m"."group"`, `"g"."group"`))
+}
+
+// weightExpression is an SQL expression of the weight of voter named by SQL
+// expression voter in the election with ID election, given its own weight.
+func weightExpression(election, voter, weight string) string {
+	return fmt.Sprintf(`COALESCE((SELECT "w"."weight" FROM "election_group_weights" AS "w" JOIN "user_groups" AS "m" ON %[4]s
+	WHERE "w"."election_id" = %[1]s AND "m"."name" = %[2]s
+	ORDER BY length("w"."group") - length(replace("w"."group", '/', '')) DESC, "w"."weight" DESC LIMIT 1), %[3]s)`,
+		election, voter, weight, groupCondition(`"m"."group"`, `"w"."group"`))
+}
+
+// groupCondition is an SQL condition that SQL expression member is group or
+// one of its subgroups.
+func groupCondition(member, group string) string {
+	return fmt.Sprintf(`(%[1]s = %[2]s OR substr(%[1]s, 1, length(%[2]s) + 1) = %[2]s || '/')`, member, group)
+}
+
+func (s *sqlStore) GetElection(name string) (Election, error) {
+	e, err := scanElection(s.db.QueryRow(`SELECT ` + electionColumns + ` FROM "elections" WHERE "name" = $1`, name))
+	if errors.Is(err, sql.ErrNoRows) {
+		return e, ErrNotFound
+	}
+	if err != nil {
+		return e, err
+	}
+	return e, s.fillElection(&e)
+}
+
+func (s *sqlStore) ListElections(f ElectionFilter) ([]Election, error) {
+	query := `SELECT ` + electionColumns + ` FROM "elections" WHERE "id" > $1`
+	args := []any{f.After}
+	if f.Eligible != "" {
+		args = append(args, f.Eligible)
+		query += fmt.Sprintf(` AND EXISTS (SELECT 1 FROM "election_electorate" WHERE "election_id" = "elections"."id" AND "name" = $%d)`, len(args))
+	}
+	if f.Creator != "" {
+		args = append(args, f.Creator)
+		query += fmt.Sprintf(` AND "creator" = $%d`, len(args))
+	}
+	query += ` ORDER BY "id"`
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	list := []Election{}
+	for (f.Limit <= 0 || len(list) < f.Limit) && rows.Next() {
+		e, err := scanElection(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		// dates are text, compare them here rather than in SQL
+		if !f.OpenAt.IsZero() && (e.Cancelled || !f.OpenAt.Before(e.EndDate)) {
+			continue
+		}
+		if !f.ClosedAt.IsZero() && f.ClosedAt.Before(e.EndDate) {
+			continue
+		}
+		list = append(list, e)
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range list {
+		err = s.fillElection(&list[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+func (s *sqlStore) PendingRunoffs(at time.Time) ([]Election, error) {
+	rows, err := s.db.Query(`SELECT ` + electionColumns + ` FROM "elections" WHERE "runoff_seconds" > 0 AND NOT "runoff_settled" AND NOT "cancelled" ORDER BY "id"`)
+	if err != nil {
+		return nil, err
+	}
+	list := []Election{}
+	for rows.Next() {
+		e, err := scanElection(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		// dates are text, compare them here rather than in SQL
+		if at.Before(e.EndDate) {
+			continue
+		}
+		list = append(list, e)
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range list {
+		err = s.fillElection(&list[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+func (s *sqlStore) SettleRunoff(electionID int64, runoff *Election) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	res, err := tx.Exec(`UPDATE "elections" SET "runoff_settled" = TRUE WHERE "id" = $1 AND NOT "runoff_settled"`, electionID)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	if runoff != nil {
+		id, err := s.createElection(tx, *runoff)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`UPDATE "elections" SET "runoff_id" = $1 WHERE "id" = $2`, id, electionID)
+		if err != nil {
+			return err
+		}
+		// the same voters as the first round, whatever changed since
+		_, err = tx.Exec(`DELETE FROM "election_electorate" WHERE "election_id" = $1`, id)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`INSERT INTO "election_electorate" ("election_id", "name", "weight")
+SELECT "e"."id", "o"."name", "o"."weight" FROM "elections" AS "e", "election_electorate" AS "o"
+WHERE "e"."id" = $1 AND "o"."election_id" = $2`, id, electionID)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`UPDATE "elections" SET "snapshot_date" = (SELECT "snapshot_date" FROM "elections" WHERE "id" = $2) WHERE "id" = $1`, id, electionID)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *sqlStore) strings(query string, args ...any) ([]string, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	list := []string{}
+	for rows.Next() {
+		var v string
+		err = rows.Scan(&v)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+	return list, rows.Err()
+}
+
+// questions returns the questions of an election, with choices.
+func (s *sqlStore) questions(electionID int64) ([]Question, error) {
+	rows, err := s.db.Query(`SELECT "id", "title", "type", "threshold", "allow_write_ins", "points", "budget", "funding" FROM "election_questions" WHERE "election_id" = $1 ORDER BY "position"`, electionID)
+	if err != nil {
+		return nil, err
+	}
+	list := []Question{}
+	ids := []int64{}
+	for rows.Next() {
+		var (
+			id int64
+			q Question
+		)
+		err = rows.Scan(&id, &q.Title, &q.Type, &q.Threshold, &q.AllowWriteIns, &q.Points, &q.Budget, &q.Funding)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		list = append(list, q)
+		ids = append(ids, id)
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return nil, err
+	}
+	for i, id := range ids {
+		err = s.choices(id, &list[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+// choices fills choices of q with questionID, and their costs if of
+// BallotBudget.
+func (s *sqlStore) choices(questionID int64, q *Question) error {
+	rows, err := s.db.Query(`SELECT "choice", "cost" FROM "election_choices" WHERE "question_id" = $1 ORDER BY "id"`, questionID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	q.Choices = []string{}
+	for rows.Next() {
+		var (
+			choice string
+			cost int64
+		)
+		err = rows.Scan(&choice, &cost)
+		if err != nil {
+			return err
+		}
+		q.Choices = append(q.Choices, choice)
+		if q.Type == BallotBudget {
+			q.Costs = append(q.Costs, cost)
+		}
+	}
+	return rows.Err()
+}
+
+func (s *sqlStore) CastBallot(b Ballot) (bool, error) {
+	replaced := false
+	err := s.serializable(func(tx *sql.Tx) error {
+		replaced = false
+		var (
+			id int64
+			timeStr string
+			allowRevote, settled, cancelled bool
+			nominationsStr sql.NullString
+		)
+		err := tx.QueryRow(`SELECT "id", "end_date", "allow_revote", "nominations_end", "nominations_settled", "cancelled" FROM "elections" WHERE "name" = $1`, b.Election).
+			Scan(&id, &timeStr, &allowRevote, &nominationsStr, &settled, &cancelled)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		var endTime time.Time
+		err = endTime.UnmarshalText([]byte(timeStr))
+		if err != nil {
+			return err
+		}
+		if endTime.Before(b.At) {
+			return ErrElectionEnded
+		}
+		if nominationsStr.Valid && !settled {
+			return ErrNotOpen
+		}
+		if cancelled {
+			return ErrCancelled
+		}
+
+		var n int
+		err = tx.QueryRow(`SELECT COUNT(*) FROM "users" WHERE "name" = $1`, b.User).Scan(&n)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return ErrVoterNotFound
+		}
+
+		var weight int64
+		err = tx.QueryRow(`SELECT "weight" FROM "election_electorate" WHERE "election_id" = $1 AND "name" = $2`, id, b.User).Scan(&weight)
+		if errors.Is(err, sql.ErrNoRows) {
+			return s.whyNotEligible(tx, id, b.User)
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(b.Answers) == 0 {
+			return ErrBadAnswer
+		}
+		answers := []answer{}
+		answered := map[int]bool{}
+		for _, a := range b.Answers {
+			if answered[a.Question] {
+				return ErrBadAnswer
+			}
+			answered[a.Question] = true
+			var (
+				questionID int64
+				typ BallotType
+				writeIns bool
+				points, choices int
+				budget int64
+			)
+			err = tx.QueryRow(`SELECT "id", "type", "allow_write_ins", "points", "budget" FROM "election_questions" WHERE "election_id" = $1 AND "position" = $2`, id, a.Question).
+				Scan(&questionID, &typ, &writeIns, &points, &budget)
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrNoSuchQuestion
+			}
+			if err != nil {
+				return err
+			}
+			if a.Abstain {
+				answers = append(answers, answer{questionID: questionID})
+				continue
+			}
+			if len(a.Choices) == 0 || typ == BallotSingle && len(a.Choices) > 1 ||
+				(typ == BallotCumulative || typ == BallotQuadratic) && len(a.Points) != len(a.Choices) {
+				return ErrBadAnswer
+			}
+			if typ == BallotBorda {
+				err = tx.QueryRow(`SELECT COUNT(*) FROM "election_choices" WHERE "question_id" = $1`, questionID).Scan(&choices)
+				if err != nil {
+					return err
+				}
+			}
+			// by name as counted, write-ins normalized
+			chosen := map[string]bool{}
+			for i, c := range a.Choices {
+				var given int
+				switch typ {
+				case BallotCumulative:
+					given = a.Points[i]
+					points -= given
+					if given <= 0 || points < 0 {
+						return ErrBadAnswer
+					}
+				case BallotBorda:
+					// the last of all choices gets none
+					given = choices - 1 - i
+				case BallotQuadratic:
+					given = a.Points[i]
+					points -= given * given
+					if given == 0 || points < 0 {
+						return ErrBadAnswer
+					}
+				}
+				var choiceID, cost int64
+				err = tx.QueryRow(`SELECT "id", "cost" FROM "election_choices" WHERE "question_id" = $1 AND "choice" = $2`, questionID, c).Scan(&choiceID, &cost)
+				if errors.Is(err, sql.ErrNoRows) && writeIns {
+					writeIn := strings.Join(strings.Fields(c), " ")
+					if writeIn == "" || chosen[writeIn] {
+						return ErrBadAnswer
+					}
+					chosen[writeIn] = true
+					answers = append(answers, answer{questionID: questionID, writeIn: writeIn, points: given})
+					continue
+				}
+				if errors.Is(err, sql.ErrNoRows) {
+					return ErrNoSuchChoice
+				}
+				if err != nil {
+					return err
+				}
+				if chosen[c] {
+					return ErrBadAnswer
+				}
+				chosen[c] = true
+				if typ == BallotBudget {
+					budget -= cost
+					if budget < 0 {
+						return ErrBadAnswer
+					}
+				}
+				answers = append(answers, answer{questionID: questionID, choiceID: choiceID, points: given})
+			}
+		}
+
+		err = tx.QueryRow(`SELECT COUNT(*) FROM "election_voted" WHERE "election_id" = $1 AND "user" = $2`, id, b.User).Scan(&n)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			if !allowRevote {
+				return ErrAlreadyVoted
+			}
+			replaced = true
+		} else {
+			// the unique index settles races the read above can't see
+			_, err = tx.Exec(`INSERT INTO "election_voted" ("election_id", "user") VALUES ($1, $2)`, id, b.User)
+			if err != nil {
+				if s.isConflict(err) && allowRevote {
+					return ErrConflict
+				}
+				if s.isConflict(err) {
+					return ErrAlreadyVoted
+				}
+				return err
+			}
+		}
+		var ballotID int64
+		err = tx.QueryRow(`INSERT INTO "ballots" ("election_id", "user", "weight", "cast_at") VALUES ($1, $2, $3, $4) RETURNING "id"`,
+			id, b.User, weight, timeText(b.At)).Scan(&ballotID)
+		if err != nil {
+			return err
+		}
+		for _, a := range answers {
+			_, err = tx.Exec(`INSERT INTO "ballot_answers" ("ballot_id", "question_id", "choice_id", "write_in", "abstain", "points") VALUES ($1, $2, $3, $4, $5, $6)`,
+				ballotID, a.questionID, sql.NullInt64{Int64: a.choiceID, Valid: a.choiceID != 0},
+				sql.NullString{String: a.writeIn, Valid: a.writeIn != ""}, a.abstain(), a.points)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return replaced, err
+}
+
+// whyNotEligible tells why user is not in the electorate.
+func (s *sqlStore) whyNotEligible(tx *sql.Tx, electionID int64, user string) error {
+	var exclude bool
+	err := tx.QueryRow(`SELECT "exclude" FROM "election_roll" WHERE "election_id" = $1 AND "name" = $2`, electionID, user).Scan(&exclude)
+	if err == nil && exclude {
+		return ErrExcluded
+	}
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	var n int
+	err = tx.QueryRow(`SELECT COUNT(*) FROM "users" WHERE "name" = $2 AND ` + eligibleCondition("$1", "$2"), electionID, user).Scan(&n)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return ErrNotInElectorate
+	}
+	return ErrNotEligible
+}
+
+func (s *sqlStore) Turnout(electionID int64) (int, int64, error) {
+	var (
+		n int
+		weight int64
+	)
+	err := s.db.QueryRow(`SELECT COUNT(*), COALESCE(SUM(COALESCE("e"."weight", 1)), 0) FROM "election_voted" AS "v"
+LEFT JOIN "election_electorate" AS "e" ON "e"."election_id" = "v"."election_id" AND "e"."name" = "v"."user"
+WHERE "v"."election_id" = $1`, electionID).Scan(&n, &weight)
+	return n, weight, err
+}
+
+// answer of a counted ballot, for choiceID or writeIn, or an abstention if
+// neither.
+type answer struct {
+	questionID	int64
+	choiceID	int64
+	writeIn	string
+	// given to the choice, for point-based ballot types
+	points	int
+}
+
+func (a answer) abstain() bool {
+	return a.choiceID == 0 && a.writeIn == ""
+}
+
+// counted is the last ballot of a voter.
+type counted struct {
+	weight	int64
+	answers	[]answer
+}
+
+// lastBallots returns the last ballot of each voter in an election.
+func (s *sqlStore) lastBallots(electionID int64) (map[string]*counted, error) {
+	rows, err := s.db.Query(`SELECT "b"."user", "b"."weight", "a"."question_id", "a"."choice_id", "a"."write_in", "a"."points" FROM "ballots" AS "b"
+JOIN "ballot_answers" AS "a" ON "a"."ballot_id" = "b"."id"
+WHERE "b"."election_id" = $1 AND "b"."id" = (SELECT MAX("id") FROM "ballots" WHERE "election_id" = "b"."election_id" AND "user" = "b"."user")
+ORDER BY "a"."id"`, electionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	ballots := map[string]*counted{}
+	for rows.Next() {
+		var (
+			user string
+			weight int64
+			a answer
+			choiceID sql.NullInt64
+			writeIn sql.NullString
+		)
+		err = rows.Scan(&user, &weight, &a.questionID, &choiceID, &writeIn, &a.points)
+		if err != nil {
+			return nil, err
+		}
+		a.choiceID, a.writeIn = choiceID.Int64, writeIn.String
+		b, ok := ballots[user]
+		if !ok {
+			b = &counted{weight: weight}
+			ballots[user] = b
+		}
+		b.answers = append(b.answers, a)
+	}
+	return ballots, rows.Err()
+}
+
+func (s *sqlStore) Tally(electionID int64) ([]Tally, int32, int64, error) {
+	rows, err := s.db.Query(`SELECT "id", "type" FROM "election_questions" WHERE "election_id" = $1 ORDER BY "position"`, electionID)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	list := []Tally{}
+	questions := map[int64]int{}
+	types := []BallotType{}
+	for rows.Next() {
+		var (
+			id int64
+			typ BallotType
+		)
+		err = rows.Scan(&id, &typ)
+		if err != nil {
+			rows.Close()
+			return nil, 0, 0, err
+		}
+		questions[id] = len(list)
+		list = append(list, Tally{Counts: []Count{}})
+		types = append(types, typ)
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	// votes of ballots from before they were stored
+	rows, err = s.db.Query(`SELECT "id", "question_id", "choice", "votes", "weighted_votes" FROM "election_choices" WHERE "election_id" = $1 ORDER BY "id"`, electionID)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	type position struct {
+		question, choice int
+	}
+	choices := map[int64]position{}
+	// of each question, by name
+	byName := make([]map[string]int, len(list))
+	for i := range byName {
+		byName[i] = map[string]int{}
+	}
+	for rows.Next() {
+		var (
+			id, questionID int64
+			c Count
+		)
+		err = rows.Scan(&id, &questionID, &c.Choice, &c.Votes, &c.Weighted)
+		if err != nil {
+			rows.Close()
+			return nil, 0, 0, err
+		}
+		t := &list[questions[questionID]]
+		choices[id] = position{questions[questionID], len(t.Counts)}
+		byName[questions[questionID]][c.Choice] = len(t.Counts)
+		t.Counts = append(t.Counts, c)
+		t.Voters += c.Votes
+		t.VoterWeight += c.Weighted
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	rows, err = s.db.Query(`SELECT "a"."question_id", "a"."write_in", "a"."choice" FROM "write_in_adjudications" AS "a"
+JOIN "election_questions" AS "q" ON "q"."id" = "a"."question_id" WHERE "q"."election_id" = $1`, electionID)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	adjudicated := make([]map[string]string, len(list))
+	for i := range adjudicated {
+		adjudicated[i] = map[string]string{}
+	}
+	for rows.Next() {
+		var (
+			questionID int64
+			writeIn, choice string
+		)
+		err = rows.Scan(&questionID, &writeIn, &choice)
+		if err != nil {
+			rows.Close()
+			return nil, 0, 0, err
+		}
+		adjudicated[questions[questionID]][writeIn] = choice
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	// of each question, by name as adjudicated, and as cast
+	writeIns := make([]map[string]int, len(list))
+	cast := make([]map[string]bool, len(list))
+	for i := range writeIns {
+		writeIns[i], cast[i] = map[string]int{}, map[string]bool{}
+	}
+	// writeIn is the count of a write-in as adjudicated
+	writeIn := func(q int, raw string) *Count {
+		cast[q][raw] = true
+		name, ok := adjudicated[q][raw]
+		if !ok {
+			name = raw
+		}
+		if i, ok := byName[q][name]; ok {
+			return &list[q].Counts[i]
+		}
+		i, ok := writeIns[q][name]
+		if !ok {
+			i = len(list[q].WriteIns)
+			writeIns[q][name] = i
+			list[q].WriteIns = append(list[q].WriteIns, Count{Choice: name})
+		}
+		return &list[q].WriteIns[i]
+	}
+	add := func(b *counted, weight int64, proxy bool) {
+		answered := map[int]bool{}
+		approvals := map[int]*Approval{}
+		// write-ins adjudicated into the same choice count as one vote,
+		// with the points given to each; by name, as WriteIns grows
+		type key struct {
+			question	int
+			choice	string
+		}
+		counted := map[key]bool{}
+		for _, a := range b.answers {
+			q := questions[a.questionID]
+			c := &list[q].Abstentions
+			if !a.abstain() {
+				if a.writeIn != "" {
+					c = writeIn(q, a.writeIn)
+				} else {
+					c = &list[q].Counts[choices[a.choiceID].choice]
+				}
+				if !answered[q] {
+					answered[q] = true
+					list[q].Voters++
+					list[q].VoterWeight += weight
+				}
+				// no write-ins, as they have no costs
+				if types[q] == BallotBudget {
+					if approvals[q] == nil {
+						approvals[q] = &Approval{Weight: weight}
+					}
+					approvals[q].Choices = append(approvals[q].Choices, choices[a.choiceID].choice)
+				}
+			}
+			c.Points += int64(a.points) * weight
+			if types[q] == BallotQuadratic {
+				c.Credits += int64(a.points) * int64(a.points) * weight
+			}
+			if counted[key{q, c.Choice}] {
+				continue
+			}
+			counted[key{q, c.Choice}] = true
+			c.Votes++
+			c.Weighted += weight
+			if proxy {
+				c.Proxies++
+				c.ProxyWeight += weight
+			}
+		}
+		for q, a := range approvals {
+			sort.Ints(a.Choices)
+			list[q].Approvals = append(list[q].Approvals, *a)
+		}
+	}
+	ballots, err := s.lastBallots(electionID)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	for _, b := range ballots {
+		add(b, b.weight, false)
+	}
+
+	proxies, err := s.proxies(electionID)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	var (
+		n int32
+		weight int64
+	)
+	for _, p := range proxies {
+		// ballots from before they were recorded can't be followed
+		if b, ok := ballots[p.delegate]; ok {
+			add(b, p.weight, true)
+			n++
+			weight += p.weight
+		}
+	}
+
+	for q := range list {
+		raws := []string{}
+		for raw := range cast[q] {
+			raws = append(raws, raw)
+		}
+		sort.Strings(raws)
+		for _, raw := range raws {
+			if c := writeIn(q, raw); c.Choice != raw {
+				c.Merged = append(c.Merged, raw)
+			}
+		}
+		sort.Slice(list[q].WriteIns, func(i, j int) bool {
+			return list[q].WriteIns[i].Choice < list[q].WriteIns[j].Choice
+		})
+		// ballots come in no particular order
+		sort.Slice(list[q].Approvals, func(i, j int) bool {
+			a, b := list[q].Approvals[i], list[q].Approvals[j]
+			for k := 0; k < len(a.Choices) && k < len(b.Choices); k++ {
+				if a.Choices[k] != b.Choices[k] {
+					return a.Choices[k] < b.Choices[k]
+				}
+			}
+			if len(a.Choices) != len(b.Choices) {
+				return len(a.Choices) < len(b.Choices)
+			}
+			return a.Weight < b.Weight
+		})
+	}
+	return list, n, weight, nil
+}
+
+func (s *sqlStore) AdjudicateWriteIns(electionID int64, question int, merges map[string]string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var questionID int64
+	err = tx.QueryRow(`SELECT "id" FROM "election_questions" WHERE "election_id" = $1 AND "position" = $2`, electionID, question).Scan(&questionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNoSuchQuestion
+	}
+	if err != nil {
+		return err
+	}
+	for writeIn, choice := range merges {
+		if writeIn == choice {
+			_, err = tx.Exec(`DELETE FROM "write_in_adjudications" WHERE "question_id" = $1 AND "write_in" = $2`, questionID, writeIn)
+		} else {
+			_, err = tx.Exec(`INSERT INTO "write_in_adjudications" ("question_id", "write_in", "choice") VALUES ($1, $2, $3)
+ON CONFLICT ("question_id", "write_in") DO UPDATE SET "choice" = EXCLUDED."choice"`, questionID, writeIn, choice)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// timeText is how timestamps are stored, as text for both backends.
+func timeText(t time.Time) string {
+	b, _ := t.UTC().MarshalText()
+	return string(b)
+}
@@ -0,0 +1,887 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// postgresDSNEnv names the variable with the DSN of a PostgreSQL database
+// to test against, where each test gets a schema of its own, as make
+// test-postgres sets for a throwaway one. Tests on PostgreSQL are skipped
+// without it.
+const postgresDSNEnv = "EVOTING_TEST_POSTGRES"
+
+// sqlBackend is something eachBackend runs tests on, migrated and empty.
+type sqlBackend struct {
+	name	string
+	open	func(t *testing.T) Store
+}
+
+var sqlBackends = []sqlBackend{
+	{"sqlite", openTestSQLite},
+	{"postgres", openTestPostgres},
+}
+
+func openTestSQLite(t *testing.T) Store {
+	dir := t.TempDir()
+	keysDir := path.Join(dir, "keys")
+	err := os.Mkdir(keysDir, 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := OpenSQLite(path.Join(dir, "db.sqlite"), keysDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func openTestPostgres(t *testing.T) Store {
+	dsn := os.Getenv(postgresDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s not set", postgresDSNEnv)
+	}
+	admin, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { admin.Close() })
+	schema := fmt.Sprintf("evoting_test_%d", time.Now().UnixNano())
+	_, err = admin.Exec(`CREATE SCHEMA "` + schema + `"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { admin.Exec(`DROP SCHEMA "` + schema + `" CASCADE`) })
+
+	// every pooled connection needs it, so as a run-time parameter
+	if strings.Contains(dsn, "://") {
+		u, err := url.Parse(dsn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		q := u.Query()
+		q.Set("search_path", schema)
+		u.RawQuery = q.Encode()
+		dsn = u.String()
+	} else {
+		dsn += " search_path=" + schema
+	}
+	s, err := OpenPostgres(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+// eachBackend runs f as a subtest on each of sqlBackends, migrated.
+func eachBackend(t *testing.T, f func(t *testing.T, s Store)) {
+	for _, b := range sqlBackends {
+		b := b
+		t.Run(b.name, func(t *testing.T) {
+			s := b.open(t)
+			err := s.Migrate()
+			if err != nil {
+				t.Fatalf("cannot migrate: %v", err)
+			}
+			f(t, s)
+		})
+	}
+}
+
+// testElection is an election of a single question between a and b, open
+// to group g1 from an hour ago for a day.
+func testElection(name string, revote bool) Election {
+	now := time.Now()
+	return Election{
+		Name: name,
+		Groups: []string{"g1"},
+		Questions: []Question{{Choices: []string{"a", "b"}}},
+		StartDate: now.Add(-time.Hour),
+		EndDate: now.Add(24 * time.Hour),
+		Creator: "alice",
+		AllowRevote: revote,
+	}
+}
+
+// testDB is the database of s, to check what Store doesn't tell.
+func testDB(s Store) *sql.DB {
+	switch s := s.(type) {
+	case *SQLite:
+		return s.db
+	case *Postgres:
+		return s.db
+	}
+	return nil
+}
+
+func addTestVoters(t *testing.T, s Store, voters ...Voter) {
+	for _, v := range voters {
+		err := s.AddVoter(v)
+		if err != nil {
+			t.Fatalf("cannot add voter %s: %v", v.Name, err)
+		}
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	eachBackend(t, func(t *testing.T, s Store) {
+		v, err := s.SchemaVersion()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != LatestSchemaVersion() {
+			t.Errorf("schema version %d after Migrate, want %d", v, LatestSchemaVersion())
+		}
+		// again, with nothing to do
+		err = s.Migrate()
+		if err != nil {
+			t.Fatalf("cannot migrate again: %v", err)
+		}
+		v, err = s.SchemaVersion()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != LatestSchemaVersion() {
+			t.Errorf("schema version %d after migrating again, want %d", v, LatestSchemaVersion())
+		}
+	})
+}
+
+func TestVoters(t *testing.T) {
+	eachBackend(t, func(t *testing.T, s Store) {
+		tests := []struct {
+			voter	Voter
+			err	error
+		}{
+			{Voter{Name: "alice", Groups: []string{"g1"}}, nil},
+			{Voter{Name: "bob", Groups: []string{"g1", "g2/sub"}, Weight: 3}, nil},
+			{Voter{Name: "alice", Groups: []string{"g2"}}, ErrExists},
+		}
+		for _, tt := range tests {
+			err := s.AddVoter(tt.voter)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("AddVoter(%+v) = %v, want %v", tt.voter, err, tt.err)
+			}
+		}
+		err := s.AddMembership("alice", "g3")
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = s.RemoveMembership("bob", "g1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = s.AddMembership("carol", "g1")
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("AddMembership(carol) = %v, want %v", err, ErrNotFound)
+		}
+		err = s.UpdateVoter("alice", nil, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = s.UpdateVoter("bob", []string{"g2/sub", "g4"}, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = s.UpdateVoter("carol", []string{"g1"}, 2)
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("UpdateVoter(carol) = %v, want %v", err, ErrNotFound)
+		}
+
+		gets := []struct {
+			name	string
+			groups	[]string
+			weight	int64
+			err	error
+		}{
+			{"alice", []string{"g1", "g3"}, 2, nil},
+			{"bob", []string{"g2/sub", "g4"}, 3, nil},
+			{"carol", nil, 0, ErrNotFound},
+		}
+		for _, tt := range gets {
+			v, err := s.GetVoter(tt.name)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("GetVoter(%s) = %v, want %v", tt.name, err, tt.err)
+				continue
+			}
+			if err != nil {
+				continue
+			}
+			if !reflect.DeepEqual(v.Groups, tt.groups) || v.Weight != tt.weight {
+				t.Errorf("GetVoter(%s) = %+v, want groups %v and weight %d", tt.name, v, tt.groups, tt.weight)
+			}
+		}
+	})
+}
+
+func TestKeys(t *testing.T) {
+	eachBackend(t, func(t *testing.T, s Store) {
+		key := []byte("0123456789abcdef0123456789abcdef")
+		err := s.PutKey("alice", key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := s.GetKey("alice")
+		if err != nil || string(got) != string(key) {
+			t.Errorf("GetKey(alice) = %x, %v, want %x", got, err, key)
+		}
+		_, err = s.GetKey("bob")
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("GetKey(bob) = %v, want %v", err, ErrNotFound)
+		}
+		err = s.RemoveKey("alice")
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = s.GetKey("alice")
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("GetKey(alice) after RemoveKey = %v, want %v", err, ErrNotFound)
+		}
+	})
+}
+
+func TestElections(t *testing.T) {
+	eachBackend(t, func(t *testing.T, s Store) {
+		addTestVoters(t, s, Voter{Name: "alice", Groups: []string{"g1"}},
+			Voter{Name: "bob", Groups: []string{"g1/sub"}, Weight: 2}, Voter{Name: "carol", Groups: []string{"g2"}})
+		e := testElection("e1", false)
+		e.Quorum = 50
+		e.Roll = []RollEntry{{Name: "carol"}}
+		err := s.CreateElection(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = s.CreateElection(e)
+		if !errors.Is(err, ErrExists) {
+			t.Errorf("CreateElection of an existing name = %v, want %v", err, ErrExists)
+		}
+
+		got, err := s.GetElection("e1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Name != e.Name || got.Creator != e.Creator || got.Quorum != e.Quorum || got.AllowRevote != e.AllowRevote ||
+			!got.EndDate.Equal(e.EndDate) || !reflect.DeepEqual(got.Groups, e.Groups) {
+			t.Errorf("GetElection(e1) = %+v, want %+v", got, e)
+		}
+		if len(got.Questions) != 1 || !reflect.DeepEqual(got.Questions[0].Choices, e.Questions[0].Choices) {
+			t.Errorf("GetElection(e1) questions = %+v, want %+v", got.Questions, e.Questions)
+		}
+		// alice and bob by group, carol by roll
+		if got.Electorate != 3 || got.ElectorateWeight != 4 {
+			t.Errorf("GetElection(e1) electorate = %d weighing %d, want 3 weighing 4", got.Electorate, got.ElectorateWeight)
+		}
+
+		// bob by the most specific override
+		weighted := testElection("e3", false)
+		weighted.GroupWeights = []GroupWeight{{Group: "g1", Weight: 3}, {Group: "g1/sub", Weight: 5}}
+		err = s.CreateElection(weighted)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err = s.GetElection("e3")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Electorate != 2 || got.ElectorateWeight != 8 || !reflect.DeepEqual(got.GroupWeights, weighted.GroupWeights) {
+			t.Errorf("GetElection(e3) = %+v, want 2 voters weighing 8 by %+v", got, weighted.GroupWeights)
+		}
+
+		_, err = s.GetElection("e2")
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("GetElection(e2) = %v, want %v", err, ErrNotFound)
+		}
+	})
+}
+
+func TestRoll(t *testing.T) {
+	eachBackend(t, func(t *testing.T, s Store) {
+		addTestVoters(t, s, Voter{Name: "alice", Groups: []string{"g1"}}, Voter{Name: "bob", Groups: []string{"g1"}},
+			Voter{Name: "carol", Groups: []string{"g2"}}, Voter{Name: "dave", Groups: []string{"g2"}})
+		e := testElection("e1", false)
+		e.Roll = []RollEntry{{Name: "bob", Exclude: true}, {Name: "carol"}}
+		err := s.CreateElection(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		e, err = s.GetElection("e1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		// alice by group, carol by roll
+		if e.Electorate != 2 {
+			t.Errorf("GetElection(e1) electorate = %d, want 2", e.Electorate)
+		}
+		// dave joins, carol leaves, once the electorate is frozen again
+		err = s.UpdateRoll(e.ID, []RollEntry{{Name: "dave"}}, []string{"carol"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := s.GetElection("e1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []RollEntry{{Name: "bob", Exclude: true}, {Name: "dave"}}
+		if !reflect.DeepEqual(got.Roll, want) {
+			t.Errorf("GetElection(e1) roll = %+v, want %+v", got.Roll, want)
+		}
+
+		tests := []struct {
+			user	string
+			err	error
+		}{
+			{"alice", nil},
+			{"bob", ErrExcluded},
+			{"carol", nil},
+			{"dave", ErrNotInElectorate},
+		}
+		for _, tt := range tests {
+			_, err = s.CastBallot(Ballot{Election: "e1", User: tt.user, Answers: []Answer{{Choices: []string{"a"}}}, At: time.Now()})
+			if !errors.Is(err, tt.err) {
+				t.Errorf("CastBallot by %s = %v, want %v", tt.user, err, tt.err)
+			}
+		}
+
+		err = s.Snapshot(e.ID, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, user := range []string{"alice", "bob", "carol", "dave"} {
+			list, err := s.ListElections(ElectionFilter{Eligible: user})
+			if err != nil {
+				t.Fatal(err)
+			}
+			eligible := 0
+			if user == "alice" || user == "dave" {
+				eligible = 1
+			}
+			if len(list) != eligible {
+				t.Errorf("ListElections eligible to %s = %+v, want %d elections", user, list, eligible)
+			}
+		}
+		_, err = s.CastBallot(Ballot{Election: "e1", User: "dave", Answers: []Answer{{Choices: []string{"a"}}}, At: time.Now()})
+		if err != nil {
+			t.Errorf("CastBallot by dave after Snapshot = %v", err)
+		}
+		n, weight, err := s.Turnout(e.ID)
+		if err != nil || n != 3 || weight != 3 {
+			t.Errorf("Turnout = %d, %d, %v, want 3, 3", n, weight, err)
+		}
+	})
+}
+
+func TestCastBallotAndTally(t *testing.T) {
+	eachBackend(t, func(t *testing.T, s Store) {
+		addTestVoters(t, s, Voter{Name: "alice", Groups: []string{"g1"}}, Voter{Name: "bob", Groups: []string{"g1"}, Weight: 2},
+			Voter{Name: "carol", Groups: []string{"g1"}}, Voter{Name: "dave", Groups: []string{"g2"}})
+		err := s.CreateElection(testElection("e1", false))
+		if err != nil {
+			t.Fatal(err)
+		}
+		now := time.Now()
+		vote := func(user string, choices ...string) Ballot {
+			return Ballot{Election: "e1", User: user, Answers: []Answer{{Choices: choices}}, At: now}
+		}
+
+		tests := []struct {
+			ballot	Ballot
+			err	error
+		}{
+			{vote("alice", "a"), nil},
+			{vote("bob", "b"), nil},
+			{vote("alice", "b"), ErrAlreadyVoted},
+			{vote("carol", "c"), ErrNoSuchChoice},
+			{vote("carol", "a", "b"), ErrBadAnswer},
+			{vote("dave", "a"), ErrNotEligible},
+			{vote("erin", "a"), ErrVoterNotFound},
+			{Ballot{Election: "e1", User: "carol", Answers: []Answer{{Question: 1, Choices: []string{"a"}}}, At: now}, ErrNoSuchQuestion},
+			{Ballot{Election: "e2", User: "carol", Answers: []Answer{{Choices: []string{"a"}}}, At: now}, ErrNotFound},
+			{Ballot{Election: "e1", User: "carol", Answers: []Answer{{Choices: []string{"a"}}}, At: now.Add(48 * time.Hour)}, ErrElectionEnded},
+			{Ballot{Election: "e1", User: "carol", Answers: []Answer{{Abstain: true}}, At: now}, nil},
+		}
+		for _, tt := range tests {
+			replaced, err := s.CastBallot(tt.ballot)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("CastBallot(%+v) = %v, want %v", tt.ballot, err, tt.err)
+			}
+			if replaced {
+				t.Errorf("CastBallot(%+v) replaced a ballot without revotes", tt.ballot)
+			}
+		}
+
+		e, err := s.GetElection("e1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		tallies, proxies, proxyWeight, err := s.Tally(e.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tallies) != 1 || proxies != 0 || proxyWeight != 0 {
+			t.Fatalf("Tally = %+v, %d, %d, want one question without proxies", tallies, proxies, proxyWeight)
+		}
+		want := []Count{{Choice: "a", Votes: 1, Weighted: 1}, {Choice: "b", Votes: 1, Weighted: 2}}
+		if !reflect.DeepEqual(tallies[0].Counts, want) {
+			t.Errorf("Tally counts = %+v, want %+v", tallies[0].Counts, want)
+		}
+		if a := tallies[0].Abstentions; a.Votes != 1 || a.Weighted != 1 {
+			t.Errorf("Tally abstentions = %+v, want 1 weighing 1", a)
+		}
+		if tallies[0].Voters != 2 || tallies[0].VoterWeight != 3 {
+			t.Errorf("Tally voters = %d weighing %d, want 2 weighing 3", tallies[0].Voters, tallies[0].VoterWeight)
+		}
+
+		n, weight, err := s.Turnout(e.ID)
+		if err != nil || n != 3 || weight != 4 {
+			t.Errorf("Turnout = %d, %d, %v, want 3, 4", n, weight, err)
+		}
+	})
+}
+
+
+func TestRevote(t *testing.T) {
+	eachBackend(t, func(t *testing.T, s Store) {
+		addTestVoters(t, s, Voter{Name: "alice", Groups: []string{"g1"}}, Voter{Name: "bob", Groups: []string{"g1"}})
+		err := s.CreateElection(testElection("e1", true))
+		if err != nil {
+			t.Fatal(err)
+		}
+		now := time.Now()
+		tests := []struct {
+			user	string
+			choice	string
+			replaced	bool
+		}{
+			{"alice", "a", false},
+			{"bob", "a", false},
+			{"alice", "b", true},
+			{"alice", "b", true},
+		}
+		for _, tt := range tests {
+			replaced, err := s.CastBallot(Ballot{Election: "e1", User: tt.user,
+				Answers: []Answer{{Choices: []string{tt.choice}}}, At: now})
+			if err != nil || replaced != tt.replaced {
+				t.Errorf("CastBallot by %s for %s = %t, %v, want %t", tt.user, tt.choice, replaced, err, tt.replaced)
+			}
+		}
+
+		e, err := s.GetElection("e1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the last ballot of alice counts, once
+		tallies, _, _, err := s.Tally(e.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []Count{{Choice: "a", Votes: 1, Weighted: 1}, {Choice: "b", Votes: 1, Weighted: 1}}
+		if !reflect.DeepEqual(tallies[0].Counts, want) {
+			t.Errorf("Tally counts = %+v, want %+v", tallies[0].Counts, want)
+		}
+		n, _, err := s.Turnout(e.ID)
+		if err != nil || n != 2 {
+			t.Errorf("Turnout = %d, %v, want 2", n, err)
+		}
+	})
+}
+
+func TestCastBallotConcurrently(t *testing.T) {
+	const n = 50
+	for _, revote := range []bool{false, true} {
+		revote := revote
+		t.Run(fmt.Sprintf("revote=%t", revote), func(t *testing.T) {
+			eachBackend(t, func(t *testing.T, s Store) {
+				addTestVoters(t, s, Voter{Name: "alice", Groups: []string{"g1"}})
+				err := s.CreateElection(testElection("e1", revote))
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				type result struct {
+					replaced	bool
+					err	error
+				}
+				results := make(chan result, n)
+				start := make(chan struct{})
+				for i := 0; i < n; i++ {
+					choice := []string{"a", "b"}[i % 2]
+					go func() {
+						<-start
+						replaced, err := s.CastBallot(Ballot{Election: "e1", User: "alice",
+							Answers: []Answer{{Choices: []string{choice}}}, At: time.Now()})
+						results <- result{replaced, err}
+					}()
+				}
+				close(start)
+
+				first, replacing := 0, 0
+				for i := 0; i < n; i++ {
+					r := <-results
+					switch {
+					case r.err == nil && !r.replaced:
+						first++
+					case r.err == nil && revote:
+						replacing++
+					case errors.Is(r.err, ErrConflict), !revote && errors.Is(r.err, ErrAlreadyVoted):
+					default:
+						t.Errorf("CastBallot = %t, %v", r.replaced, r.err)
+					}
+				}
+				if first != 1 {
+					t.Errorf("%d ballots cast as the first, want 1", first)
+				}
+				if !revote && replacing != 0 {
+					t.Errorf("%d ballots replaced without revotes", replacing)
+				}
+
+				e, err := s.GetElection("e1")
+				if err != nil {
+					t.Fatal(err)
+				}
+				var voted int
+				err = testDB(s).QueryRow(`SELECT COUNT(*) FROM "election_voted" WHERE "election_id" = $1`, e.ID).Scan(&voted)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if voted != 1 {
+					t.Errorf("%d election_voted rows, want 1", voted)
+				}
+				// the last ballot counts, once
+				tallies, _, _, err := s.Tally(e.ID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if votes := tallies[0].Counts[0].Votes + tallies[0].Counts[1].Votes; votes != 1 {
+					t.Errorf("%d votes counted, want 1", votes)
+				}
+			})
+		})
+	}
+}
+
+func TestPointBallots(t *testing.T) {
+	eachBackend(t, func(t *testing.T, s Store) {
+		addTestVoters(t, s, Voter{Name: "alice", Groups: []string{"g1"}}, Voter{Name: "bob", Groups: []string{"g1"}, Weight: 2})
+		e := testElection("e1", false)
+		e.Questions = []Question{
+			{Type: BallotCumulative, Choices: []string{"a", "b", "c"}, Points: 5},
+			{Type: BallotBorda, Choices: []string{"a", "b", "c"}},
+		}
+		err := s.CreateElection(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		now := time.Now()
+		vote := func(user string, cumulative []string, points []int, borda ...string) Ballot {
+			return Ballot{Election: "e1", User: user, At: now, Answers: []Answer{
+				{Choices: cumulative, Points: points},
+				{Question: 1, Choices: borda},
+			}}
+		}
+
+		tests := []struct {
+			ballot	Ballot
+			err	error
+		}{
+			{vote("bob", []string{"a", "b"}, []int{4, 2}, "a"), ErrBadAnswer},
+			{vote("bob", []string{"a"}, []int{0}, "a"), ErrBadAnswer},
+			{vote("bob", []string{"a", "b"}, []int{-1, 6}, "a"), ErrBadAnswer},
+			{vote("bob", []string{"a", "b"}, []int{5}, "a"), ErrBadAnswer},
+			{vote("bob", []string{"c"}, []int{5}, "a", "a"), ErrBadAnswer},
+			{vote("alice", []string{"a", "b"}, []int{3, 2}, "c", "a", "b"), nil},
+			// ranking some of the choices
+			{vote("bob", []string{"c"}, []int{5}, "b"), nil},
+		}
+		for _, tt := range tests {
+			_, err := s.CastBallot(tt.ballot)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("CastBallot(%+v) = %v, want %v", tt.ballot, err, tt.err)
+			}
+		}
+
+		got, err := s.GetElection("e1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		tallies, _, _, err := s.Tally(got.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := [][]Count{
+			{{Choice: "a", Votes: 1, Weighted: 1, Points: 3}, {Choice: "b", Votes: 1, Weighted: 1, Points: 2},
+				{Choice: "c", Votes: 1, Weighted: 2, Points: 10}},
+			// the first of three choices gets 2 points, whoever ranks it
+			{{Choice: "a", Votes: 1, Weighted: 1, Points: 1}, {Choice: "b", Votes: 2, Weighted: 3, Points: 4},
+				{Choice: "c", Votes: 1, Weighted: 1, Points: 2}},
+		}
+		for i := range want {
+			if !reflect.DeepEqual(tallies[i].Counts, want[i]) {
+				t.Errorf("Tally counts of question %d = %+v, want %+v", i, tallies[i].Counts, want[i])
+			}
+		}
+	})
+}
+
+func TestQuadraticBallots(t *testing.T) {
+	eachBackend(t, func(t *testing.T, s Store) {
+		addTestVoters(t, s, Voter{Name: "alice", Groups: []string{"g1"}}, Voter{Name: "bob", Groups: []string{"g1"}, Weight: 2},
+			Voter{Name: "carol", Groups: []string{"g1"}})
+		e := testElection("e1", false)
+		e.Questions = []Question{{Type: BallotQuadratic, Choices: []string{"a", "b"}, Points: 9}}
+		err := s.CreateElection(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		now := time.Now()
+		vote := func(user string, choices []string, votes ...int) Ballot {
+			return Ballot{Election: "e1", User: user, At: now, Answers: []Answer{{Choices: choices, Points: votes}}}
+		}
+
+		tests := []struct {
+			ballot	Ballot
+			err	error
+		}{
+			// 9 + 1 credits of 9
+			{vote("bob", []string{"a", "b"}, 3, 1), ErrBadAnswer},
+			{vote("bob", []string{"a"}, 0), ErrBadAnswer},
+			{vote("bob", []string{"a", "b"}, -4), ErrBadAnswer},
+			// all 9 credits
+			{vote("alice", []string{"a"}, 3), nil},
+			{vote("bob", []string{"a", "b"}, -2, 2), nil},
+			{vote("carol", []string{"b"}, -1), nil},
+		}
+		for _, tt := range tests {
+			_, err := s.CastBallot(tt.ballot)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("CastBallot(%+v) = %v, want %v", tt.ballot, err, tt.err)
+			}
+		}
+
+		got, err := s.GetElection("e1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		tallies, _, _, err := s.Tally(got.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// net votes and credits spent, times weights
+		want := []Count{{Choice: "a", Votes: 2, Weighted: 3, Points: 3 - 2 * 2, Credits: 9 + 4 * 2},
+			{Choice: "b", Votes: 2, Weighted: 3, Points: 2 * 2 - 1, Credits: 4 * 2 + 1}}
+		if !reflect.DeepEqual(tallies[0].Counts, want) {
+			t.Errorf("Tally counts = %+v, want %+v", tallies[0].Counts, want)
+		}
+	})
+}
+
+func TestDelegations(t *testing.T) {
+	eachBackend(t, func(t *testing.T, s Store) {
+		addTestVoters(t, s, Voter{Name: "alice", Groups: []string{"g1"}}, Voter{Name: "bob", Groups: []string{"g1"}, Weight: 2})
+		for _, name := range []string{"carol", "dave", "erin", "frank", "gina", "henry"} {
+			addTestVoters(t, s, Voter{Name: name, Groups: []string{"g1"}})
+		}
+		err := s.CreateElection(testElection("e1", false))
+		if err != nil {
+			t.Fatal(err)
+		}
+		e, err := s.GetElection("e1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		now := time.Now()
+		group := func(delegator, delegate string) Delegation {
+			return Delegation{Delegator: delegator, Delegate: delegate, Group: "g1"}
+		}
+
+		tests := []struct {
+			delegation	Delegation
+			err	error
+		}{
+			// alice by bob by carol
+			{group("alice", "bob"), nil},
+			{group("bob", "carol"), nil},
+			{group("carol", "alice"), ErrDelegationCycle},
+			{group("carol", "zoe"), ErrVoterNotFound},
+			// the election over the group
+			{group("dave", "erin"), nil},
+			{Delegation{Delegator: "dave", Delegate: "carol", ElectionID: e.ID}, nil},
+			// voting directly
+			{group("frank", "carol"), nil},
+			// revoked after and before the end
+			{group("gina", "carol"), nil},
+			{group("henry", "carol"), nil},
+		}
+		for _, tt := range tests {
+			err := s.Delegate(tt.delegation, now)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Delegate(%+v) = %v, want %v", tt.delegation, err, tt.err)
+			}
+		}
+		err = s.RevokeDelegation("gina", 0, "g1", e.EndDate.Add(time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = s.RevokeDelegation("henry", 0, "g1", now)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for user, choice := range map[string]string{"carol": "a", "erin": "b", "frank": "b"} {
+			_, err = s.CastBallot(Ballot{Election: "e1", User: user, Answers: []Answer{{Choices: []string{choice}}}, At: now})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		tallies, proxies, proxyWeight, err := s.Tally(e.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// alice, bob, dave and gina by proxy of carol
+		want := []Count{{Choice: "a", Votes: 5, Weighted: 6, Proxies: 4, ProxyWeight: 5}, {Choice: "b", Votes: 2, Weighted: 2}}
+		if !reflect.DeepEqual(tallies[0].Counts, want) {
+			t.Errorf("Tally counts = %+v, want %+v", tallies[0].Counts, want)
+		}
+		if proxies != 4 || proxyWeight != 5 {
+			t.Errorf("Tally proxies = %d weighing %d, want 4 weighing 5", proxies, proxyWeight)
+		}
+		n, weight, err := s.Turnout(e.ID)
+		if err != nil || n != 3 || weight != 3 {
+			t.Errorf("Turnout = %d, %d, %v, want 3, 3", n, weight, err)
+		}
+	})
+}
+
+func TestSettleNominations(t *testing.T) {
+	tests := []struct {
+		name	string
+		nominee	string
+		writeIns	bool
+		cancelled	bool
+		choices	[]string
+	}{
+		{"qualified", "bob", false, false, []string{"bob"}},
+		{"none", "", false, true, []string{}},
+		{"none with write-ins", "", true, false, []string{}},
+	}
+	eachBackend(t, func(t *testing.T, s Store) {
+		addTestVoters(t, s, Voter{Name: "alice", Groups: []string{"g1"}}, Voter{Name: "bob", Groups: []string{"g1"}})
+		for i, tt := range tests {
+			now := time.Now()
+			e := testElection(fmt.Sprintf("e%d", i), false)
+			e.Questions = []Question{{AllowWriteIns: tt.writeIns}}
+			e.NominationsEnd = now.Add(-time.Minute)
+			err := s.CreateElection(e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			e, err = s.GetElection(e.Name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.nominee != "" {
+				err = s.Nominate(e.ID, "alice", tt.nominee, now.Add(-time.Hour))
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err = s.SettleNominations(e.ID)
+			if err != nil {
+				t.Fatalf("%s: SettleNominations = %v", tt.name, err)
+			}
+			err = s.SettleNominations(e.ID)
+			if !errors.Is(err, ErrNotFound) {
+				t.Errorf("%s: SettleNominations again = %v, want %v", tt.name, err, ErrNotFound)
+			}
+			got, err := s.GetElection(e.Name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Cancelled != tt.cancelled || !reflect.DeepEqual(got.Questions[0].Choices, tt.choices) {
+				t.Errorf("%s: settled as cancelled %v with choices %v, want %v with %v",
+					tt.name, got.Cancelled, got.Questions[0].Choices, tt.cancelled, tt.choices)
+			}
+
+			var wantErr error
+			if tt.cancelled {
+				wantErr = ErrCancelled
+			}
+			_, err = s.CastBallot(Ballot{Election: e.Name, User: "alice", Answers: []Answer{{Abstain: true}}, At: now})
+			if !errors.Is(err, wantErr) {
+				t.Errorf("%s: CastBallot = %v, want %v", tt.name, err, wantErr)
+			}
+		}
+	})
+}
+
+func TestTurnoutByGroupAndFirstBallots(t *testing.T) {
+	eachBackend(t, func(t *testing.T, s Store) {
+		addTestVoters(t, s, Voter{Name: "alice", Groups: []string{"g1"}}, Voter{Name: "bob", Groups: []string{"g1/sub"}, Weight: 2},
+			Voter{Name: "carol", Groups: []string{"g2"}}, Voter{Name: "dave", Groups: []string{"g1/sub", "g2"}},
+			Voter{Name: "erin", Groups: []string{"g3"}}, Voter{Name: "frank"})
+		e := testElection("e1", true)
+		e.Groups = []string{"g1", "g2"}
+		e.Roll = []RollEntry{{Name: "frank"}}
+		err := s.CreateElection(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		now := time.Now()
+		ballots := []Ballot{
+			{Election: "e1", User: "bob", Answers: []Answer{{Choices: []string{"a"}}}, At: now.Add(-30 * time.Minute)},
+			{Election: "e1", User: "dave", Answers: []Answer{{Choices: []string{"a"}}}, At: now.Add(-20 * time.Minute)},
+			// counted once, when first cast
+			{Election: "e1", User: "bob", Answers: []Answer{{Choices: []string{"b"}}}, At: now.Add(-10 * time.Minute)},
+			{Election: "e1", User: "frank", Answers: []Answer{{Choices: []string{"b"}}}, At: now.Add(-5 * time.Minute)},
+		}
+		for _, b := range ballots {
+			_, err = s.CastBallot(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		got, err := s.GetElection("e1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		groups, err := s.TurnoutByGroup(got.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// subgroups under their parents, frank by roll in none
+		want := []GroupTurnout{
+			{Group: "g1", Electorate: 3, ElectorateWeight: 4, Turnout: 2, TurnoutWeight: 3},
+			{Group: "g2", Electorate: 2, ElectorateWeight: 2, Turnout: 1, TurnoutWeight: 1},
+		}
+		if !reflect.DeepEqual(groups, want) {
+			t.Errorf("TurnoutByGroup = %+v, want %+v", groups, want)
+		}
+
+		first, err := s.FirstBallots(got.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantFirst := []FirstBallot{{At: ballots[0].At, Weight: 2}, {At: ballots[1].At, Weight: 1}, {At: ballots[3].At, Weight: 1}}
+		if len(first) != len(wantFirst) {
+			t.Fatalf("FirstBallots = %+v, want %+v", first, wantFirst)
+		}
+		for i := range first {
+			if !first[i].At.Equal(wantFirst[i].At) || first[i].Weight != wantFirst[i].Weight {
+				t.Errorf("FirstBallots = %+v, want %+v", first, wantFirst)
+				break
+			}
+		}
+	})
+}
@@ -0,0 +1,108 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"os"
+	"os/exec"
+	"path"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+const (
+	sqliteReset = `PRAGMA writable_schema = 1;DELETE FROM sqlite_master;PRAGMA writable_schema = 0;VACUUM;PRAGMA integrity_check;`
+)
+
+// SQLite keeps its database in a single file and voter keys as files in
+// keysDir, and replicates by shipping dumps of both.
+type SQLite struct {
+	sqlStore
+	path	string
+	keysDir	string
+}
+
+func OpenSQLite(dbPath, keysDir string) (*SQLite, error) {
+	// SQLite transactions are always serializable. Taking the write lock
+	// upfront makes concurrent ones wait for each other instead of failing
+	// on upgrading their locks.
+	db, err := sql.Open("sqlite", dbPath + "?_txlock=immediate&_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, err
+	}
+	return &SQLite{
+		sqlStore: sqlStore{
+			db: db,
+			migrations: sqliteMigrations,
+			isConflict: sqliteIsConflict,
+			isRetryable: sqliteIsRetryable,
+		},
+		path: dbPath,
+		keysDir: keysDir,
+	}, nil
+}
+
+func sqliteIsConflict(err error) bool {
+	var e *sqlite.Error
+	return errors.As(err, &e) && e.Code() & 0xff == sqlite3.SQLITE_CONSTRAINT
+}
+
+func sqliteIsRetryable(err error) bool {
+	var e *sqlite.Error
+	return errors.As(err, &e) && (e.Code() & 0xff == sqlite3.SQLITE_BUSY || e.Code() & 0xff == sqlite3.SQLITE_LOCKED)
+}
+
+func (s *SQLite) PutKey(name string, key []byte) error {
+	return os.WriteFile(path.Join(s.keysDir, name), key, 0600)
+}
+
+func (s *SQLite) GetKey(name string) ([]byte, error) {
+	b, err := os.ReadFile(path.Join(s.keysDir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return b, err
+}
+
+func (s *SQLite) RemoveKey(name string) error {
+	err := os.Remove(path.Join(s.keysDir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return ErrNotFound
+	}
+	return err
+}
+
+func (s *SQLite) Keys() (map[string][]byte, error) {
+	keys := map[string][]byte{}
+	files, err := os.ReadDir(s.keysDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		if !f.Type().IsRegular() {
+			continue
+		}
+		bytes, err := os.ReadFile(path.Join(s.keysDir, f.Name()))
+		if err != nil {
+			continue
+		}
+		keys[f.Name()] = bytes
+	}
+	return keys, nil
+}
+
+// Dump returns SQL that replaces a database with a copy of this one.
+func (s *SQLite) Dump() (string, error) {
+	// FIXME: is there a better way?
+	bytes, err := exec.Command("sqlite3", s.path, ".dump").Output()
+	if err != nil {
+		return "", err
+	}
+	return sqliteReset + string(bytes), nil
+}
+
+func (s *SQLite) Restore(dump string) error {
+	_, err := s.db.Exec(dump)
+	return err
+}
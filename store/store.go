@@ -0,0 +1,461 @@
+package store
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrNotFound	= errors.New("not found")
+	ErrExists	= errors.New("already exists")
+	// ErrConflict is returned when a transaction kept losing races
+	ErrConflict	= errors.New("too much contention, try again")
+
+	ErrElectionEnded	= errors.New("election ended")
+	// still taking nominations
+	ErrNotOpen	= errors.New("voting not open yet")
+	ErrCancelled	= errors.New("election cancelled")
+	ErrVoterNotFound	= errors.New("voter not registered")
+	// neither in an allowed group nor included in the roll
+	ErrNotEligible	= errors.New("voter not eligible")
+	ErrExcluded	= errors.New("voter excluded by election roll")
+	// eligible by now, but not when the electorate was frozen
+	ErrNotInElectorate	= errors.New("voter not in frozen electorate")
+	ErrNoSuchQuestion	= errors.New("no such question")
+	ErrNoSuchChoice	= errors.New("no such choice")
+	// answering a question twice, with a wrong number of choices, or
+	// giving more points, spending more credits or approving more costs
+	// than there are
+	ErrBadAnswer	= errors.New("malformed answer")
+	ErrAlreadyVoted	= errors.New("already voted")
+
+	ErrDelegationCycle	= errors.New("delegation cycle")
+
+	// seconding a nomination by or of oneself
+	ErrOwnNomination	= errors.New("own nomination")
+)
+
+// Voter is a member of Groups, which are hierarchical by '/'. Being a
+// member of engineering/backend makes one a member of engineering as well.
+type Voter struct {
+	Name	string
+	Groups	[]string
+	// of the ballots of the voter, 1 if zero
+	Weight	int64
+}
+
+// VoterFilter selects voters for ListVoters. Zero fields match everything.
+type VoterFilter struct {
+	// for paging, only voters with names sorting after it
+	After	string
+	Limit	int
+	// only members of the group or its subgroups
+	Group	string
+}
+
+type Election struct {
+	ID	int64
+	Name	string
+	Groups	[]string
+	Questions	[]Question
+	// zero for elections from before it was recorded
+	StartDate	time.Time
+	EndDate	time.Time
+	Creator	string
+	Roll	[]RollEntry
+	// override weights of voters in the groups or their subgroups
+	GroupWeights	[]GroupWeight
+	// voters may cast ballots again until EndDate, the last one counts
+	AllowRevote	bool
+	// minimum turnout, in percent of the weight of the electorate
+	Quorum	int
+	// how long a runoff between the top two choices lasts, if no choice
+	// gets a majority and the quorum is met, zero for none
+	Runoff	time.Duration
+	// names of the runoff of this election once created, and of the
+	// election this is the runoff of
+	RunoffElection	string
+	FirstRound	string
+	// voting opens at NominationsEnd instead of StartDate if set, when
+	// qualified nominees are added as choices of the first question
+	NominationsEnd	time.Time
+	SecondsRequired	int
+	AcceptanceRequired	bool
+	// as no nominee qualified for the first question, which takes no
+	// write-ins, when settling nominations, instead of opening with no
+	// choices
+	Cancelled	bool
+	// of the random beacon breaking ties, Seed kept secret until EndDate,
+	// Commitment its SHA-256, both unset for elections from before
+	Seed	[]byte
+	Commitment	[]byte
+	// may contribute to the beacon until EndDate
+	Trustees	[]Trustee
+	// may countersign the result document once ended
+	Officers	[]Officer
+	// when the electorate was frozen, its size and total weight
+	SnapshotDate	time.Time
+	Electorate	int
+	ElectorateWeight	int64
+}
+
+// Trustee contributes to the random beacon of an election.
+type Trustee struct {
+	Name	string
+	// nil until contributed
+	Contribution	[]byte
+}
+
+// Officer certifies the result of an election.
+type Officer struct {
+	Name	string
+	// of the result document as last certified, nil until then
+	Signature	[]byte
+}
+
+// Question is one of those answered on a ballot of an election.
+type Question struct {
+	Title	string
+	Type	BallotType
+	Choices	[]string
+	Threshold	Threshold
+	// choices not in Choices are counted as write-ins instead of rejected
+	AllowWriteIns	bool
+	// each voter distributes, for BallotCumulative, or voice credits each
+	// voter has, for BallotQuadratic
+	Points	int
+	// of Choices, what approved ones on a ballot may cost in total, and
+	// how choices are funded, for BallotBudget
+	Costs	[]int64
+	Budget	int64
+	Funding	Funding
+}
+
+// BallotType is how voters answer a question.
+type BallotType int
+
+const (
+	// with one choice
+	BallotSingle BallotType = iota
+	// with any number of choices, each getting the full weight of the voter
+	BallotApproval
+	// with choices given some of the points of the question each, all of
+	// them at most
+	BallotCumulative
+	// with choices ranked, the first of n choices getting n-1 points, the
+	// next n-2, and so on
+	BallotBorda
+	// with choices given votes each, against them if negative, n votes
+	// costing n² of the voice credits of the question, all of them at most
+	BallotQuadratic
+	// with choices approved, costing the budget of the question at most
+	BallotBudget
+)
+
+// Funding is how choices of a BallotBudget question are funded out of its
+// budget.
+type Funding int
+
+const (
+	// in order of weighted approvals, skipping those not fitting
+	FundingGreedy Funding = iota
+	// by the Method of Equal Shares, the budget split among voters by
+	// weight, then greedily with what is left
+	FundingEqualShares
+)
+
+// Event is something that happened to an election, kept so that watchers
+// can resume from its ID on any node.
+type Event struct {
+	ID	int64
+	ElectionID	int64
+	Kind	EventKind
+	At	time.Time
+	// of the electorate weight reached, for EventTurnout
+	Percent	int
+	// who certified, for EventCertified
+	Officer	string
+}
+
+// EventKind is what an Event is about.
+type EventKind int
+
+const (
+	// voting opened
+	EventOpened EventKind = iota
+	// turnout reached a milestone, or the quorum
+	EventTurnout
+	// voting closed
+	EventClosed
+	// the result is available, or changed by adjudication
+	EventResult
+	// an officer countersigned the result
+	EventCertified
+	// nominations left nothing to vote for, instead of opening
+	EventCancelled
+)
+
+// Threshold is what the leading choice needs to carry a question, by
+// weights of votes.
+type Threshold int
+
+const (
+	// more than any other choice
+	ThresholdPlurality Threshold = iota
+	// more than half of voters not abstaining
+	ThresholdMajority
+	// at least two thirds of voters not abstaining
+	ThresholdTwoThirds
+	// more than half of the electorate
+	ThresholdAbsoluteMajority
+)
+
+// GroupWeight overrides weights of voters in Group for an election. The
+// most specific one matching a voter counts, the largest of those if still
+// more than one.
+type GroupWeight struct {
+	Group	string
+	Weight	int64
+}
+
+// RollEntry explicitly includes a voter in an election regardless of
+// groups, or excludes them even if in an allowed group.
+type RollEntry struct {
+	Name	string
+	Exclude	bool
+}
+
+// ElectionFilter selects elections for ListElections. Zero fields match
+// everything.
+type ElectionFilter struct {
+	// for paging, only elections with larger IDs
+	After	int64
+	Limit	int
+	// only elections still open at OpenAt and not cancelled, or ended at
+	// ClosedAt
+	OpenAt	time.Time
+	ClosedAt	time.Time
+	// only elections this voter may vote in
+	Eligible	string
+	Creator	string
+}
+
+// Ballot is a vote of User in Election, cast at At. Questions without
+// answers are skipped.
+type Ballot struct {
+	Election	string
+	User	string
+	Answers	[]Answer
+	At	time.Time
+}
+
+// Answer to the question at index Question of an election.
+type Answer struct {
+	Question	int
+	// ignored if Abstain, in order of preference for BallotBorda
+	Choices	[]string
+	// given to Choices, for BallotCumulative, or votes for BallotQuadratic
+	Points	[]int
+	Abstain	bool
+}
+
+// Count of votes for Choice, including those by proxy.
+type Count struct {
+	Choice	string
+	// write-ins as cast, counted for Choice by adjudication
+	Merged	[]string
+	Votes	int32
+	// sum of weights of the ballots
+	Weighted	int64
+	// sum of points given times weights of the ballots, for point-based
+	// ballot types, net votes for BallotQuadratic
+	Points	int64
+	// spent times weights of the ballots, for BallotQuadratic
+	Credits	int64
+	// of the above, by proxy
+	Proxies	int32
+	ProxyWeight	int64
+}
+
+// GroupTurnout is the turnout among members of Group or its subgroups in
+// the electorate of an election.
+type GroupTurnout struct {
+	Group	string
+	Electorate	int
+	ElectorateWeight	int64
+	Turnout	int
+	TurnoutWeight	int64
+}
+
+// FirstBallot is when a voter first cast a ballot, with its weight.
+type FirstBallot struct {
+	At	time.Time
+	Weight	int64
+}
+
+// Tally is the result of a question.
+type Tally struct {
+	Counts	[]Count
+	// in order of Choice, as adjudicated
+	WriteIns	[]Count
+	// with Choice unset
+	Abstentions	Count
+	// of each ballot answering without abstaining, for BallotBudget, in
+	// order of Choices then Weight
+	Approvals	[]Approval
+	// voters answering without abstaining, and their total weight
+	Voters	int32
+	VoterWeight	int64
+}
+
+// Approval of Counts at indices Choices, in order, by a ballot of Weight.
+type Approval struct {
+	Weight	int64
+	Choices	[]int
+}
+
+// Nomination of Nominee by Nominator. Nominees qualify with enough seconds,
+// unless declined, and if acceptance is required, accepted.
+type Nomination struct {
+	Nominee	string
+	Nominator	string
+	Seconds	int
+	Accepted	bool
+	Declined	bool
+	Qualified	bool
+}
+
+// Delegation lets Delegate vote for Delegator in the election with
+// ElectionID, or if it is zero, in all elections allowing Group or its
+// subgroups. Delegations are transitive.
+type Delegation struct {
+	Delegator	string
+	Delegate	string
+	ElectionID	int64
+	Group	string
+}
+
+// Store is the persistent state of a server. Lookups of missing entities
+// return ErrNotFound, inserts of duplicates return ErrExists.
+type Store interface {
+	Migrate() error
+	SchemaVersion() (int, error)
+	Close() error
+
+	AddVoter(v Voter) error
+	// AddVoters adds voters all at once, or none of them if any would fail.
+	// errs tells why each would, nil for those that would not. Nothing is
+	// added on dryRun either way.
+	AddVoters(voters []Voter, dryRun bool) (errs []error, err error)
+	GetVoter(name string) (Voter, error)
+	RemoveVoter(name string) error
+	// AddMembership returns ErrNotFound if the voter does not exist.
+	AddMembership(name, group string) error
+	RemoveMembership(name, group string) error
+	// UpdateVoter replaces all memberships of a voter with groups, and its
+	// weight with weight, at once. Either is left as is if empty or zero.
+	UpdateVoter(name string, groups []string, weight int64) error
+	// ListVoters returns voters in order of name.
+	ListVoters(f VoterFilter) ([]Voter, error)
+
+	PutKey(name string, key []byte) error
+	GetKey(name string) ([]byte, error)
+	RemoveKey(name string) error
+	Keys() (map[string][]byte, error)
+
+	AddChallenge(name, value string) error
+	Challenges(name string) ([]string, error)
+	RemoveChallenge(value string) error
+
+	CreateElection(e Election) error
+	GetElection(name string) (Election, error)
+	// UpdateRoll adds or replaces entries of add and removes those named in
+	// remove from the roll of an election. It does not change the electorate
+	// until the next Snapshot.
+	UpdateRoll(electionID int64, add []RollEntry, remove []string) error
+	// Snapshot freezes who is eligible by groups and roll at the moment,
+	// and their weights, as the electorate of an election. CreateElection
+	// takes the first one.
+	Snapshot(electionID int64, at time.Time) error
+	// ListElections returns elections in order of ID.
+	ListElections(f ElectionFilter) ([]Election, error)
+	// PendingRunoffs returns elections with runoffs ended by at, whose need
+	// of one is not settled yet.
+	PendingRunoffs(at time.Time) ([]Election, error)
+	// SettleRunoff settles the runoff of an election, creating runoff as its
+	// second round unless nil, with the electorate of the election as
+	// frozen. It returns ErrNotFound if already settled.
+	SettleRunoff(electionID int64, runoff *Election) error
+
+	// CastBallot checks and records b atomically, and tells if it replaces
+	// a previous ballot. It returns ErrNotFound for unknown elections, and
+	// one of the Err* above describing why the ballot is rejected
+	// otherwise.
+	CastBallot(b Ballot) (replaced bool, err error)
+	// Tally counts the last ballot of each voter, and votes by proxy as of
+	// the end of the election, of those in the electorate who did not vote
+	// themselves. It returns one Tally for each question, and the number
+	// and total weight of those voting by proxy.
+	Tally(electionID int64) (tallies []Tally, proxies int32, proxyWeight int64, err error)
+	// AdjudicateWriteIns counts write-ins as cast for the question at index
+	// question of an election, keys of merges, as the choices they map to
+	// from then on, be it a write-in or not. Mapping a write-in to itself
+	// undoes its adjudication.
+	AdjudicateWriteIns(electionID int64, question int, merges map[string]string) error
+	// Nominate records a nomination by nominator, who must be in the
+	// electorate, of nominee, who must be registered.
+	Nominate(electionID int64, nominator, nominee string, at time.Time) error
+	// Second records a second of the nomination of nominee by user, who
+	// must be in the electorate, and neither the nominator nor the nominee.
+	Second(electionID int64, user, nominee string) error
+	RespondNomination(electionID int64, nominee string, accept bool) error
+	// ListNominations returns nominations in order of nomination.
+	ListNominations(electionID int64) ([]Nomination, error)
+	// PendingNominations returns elections whose nominations ended by at,
+	// with qualified nominees not added as choices yet.
+	PendingNominations(at time.Time) ([]Election, error)
+	// SettleNominations adds qualified nominees as choices, or cancels the
+	// election if there are none and the question takes no write-ins. It
+	// returns ErrNotFound if already settled.
+	SettleNominations(electionID int64) error
+	// Contribute records the contribution of trustee to the random beacon of
+	// an election. It returns ErrNotFound if trustee is not one of it, and
+	// ErrExists if already contributed.
+	Contribute(electionID int64, trustee string, contribution []byte) error
+	// Countersign records the signature of officer over the result document
+	// of an election, replacing the previous one. It returns ErrNotFound if
+	// officer is not one of it.
+	Countersign(electionID int64, officer string, signature []byte) error
+
+	// Turnout is the number of ballots cast, and their total weight.
+	Turnout(electionID int64) (int, int64, error)
+	// TurnoutByGroup is Turnout among the electorate for each group of an
+	// election, in order of group.
+	TurnoutByGroup(electionID int64) ([]GroupTurnout, error)
+	// FirstBallots returns the first ballot of each voter in an election,
+	// in the order cast, except those from before it was recorded when.
+	FirstBallots(electionID int64) ([]FirstBallot, error)
+
+	// AddEvent records e, with e.ID ignored.
+	AddEvent(e Event) error
+	// ListEvents returns events of an election after the one with ID
+	// after, in order of ID.
+	ListEvents(electionID, after int64) ([]Event, error)
+	// UnclosedElections returns elections without an EventClosed yet.
+	UnclosedElections() ([]Election, error)
+
+	// Delegate replaces the delegation of d.Delegator in the same scope if
+	// any. It returns ErrVoterNotFound if d.Delegate is not registered, and
+	// ErrDelegationCycle if d.Delegate delegates back in the scope.
+	Delegate(d Delegation, at time.Time) error
+	// RevokeDelegation revokes the delegation of delegator in the scope of
+	// electionID or group, as Delegation.
+	RevokeDelegation(delegator string, electionID int64, group string, at time.Time) error
+}
+
+// Replicable stores are replicated by the Sync service by shipping whole
+// dumps. Stores without it rely on the backend's own replication.
+type Replicable interface {
+	Dump() (string, error)
+	Restore(dump string) error
+}
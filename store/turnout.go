@@ -0,0 +1,54 @@
+package store
+
+import "fmt"
+
+func (s *sqlStore) TurnoutByGroup(electionID int64) ([]GroupTurnout, error) {
+	rows, err := s.db.Query(fmt.Sprintf(`SELECT "g"."group", COUNT("e"."name"), COALESCE(SUM("e"."weight"), 0),
+COUNT("v"."user"), COALESCE(SUM(CASE WHEN "v"."user" IS NULL THEN 0 ELSE "e"."weight" END), 0)
+FROM "election_groups" AS "g"
+LEFT JOIN "election_electorate" AS "e" ON "e"."election_id" = "g"."election_id"
+	AND EXISTS (SELECT 1 FROM "user_groups" AS "m" WHERE "m"."name" = "e"."name" AND %s)
+LEFT JOIN "election_voted" AS "v" ON "v"."election_id" = "e"."election_id" AND "v"."user" = "e"."name"
+WHERE "g"."election_id" = $1 GROUP BY "g"."group" ORDER BY "g"."group"`, groupCondition(`"m"."group"`, `"g"."group"`)), electionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	list := []GroupTurnout{}
+	for rows.Next() {
+		var t GroupTurnout
+		err = rows.Scan(&t.Group, &t.Electorate, &t.ElectorateWeight, &t.Turnout, &t.TurnoutWeight)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, t)
+	}
+	return list, rows.Err()
+}
+
+func (s *sqlStore) FirstBallots(electionID int64) ([]FirstBallot, error) {
+	rows, err := s.db.Query(`SELECT "cast_at", "weight" FROM "ballots" AS "b"
+WHERE "election_id" = $1 AND "cast_at" IS NOT NULL
+AND "id" = (SELECT MIN("id") FROM "ballots" WHERE "election_id" = "b"."election_id" AND "user" = "b"."user") ORDER BY "id"`, electionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	list := []FirstBallot{}
+	for rows.Next() {
+		var (
+			b FirstBallot
+			at string
+		)
+		err = rows.Scan(&at, &b.Weight)
+		if err != nil {
+			return nil, err
+		}
+		err = b.At.UnmarshalText([]byte(at))
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, b)
+	}
+	return list, rows.Err()
+}